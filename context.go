@@ -0,0 +1,50 @@
+package strftime
+
+import (
+	"context"
+	"time"
+)
+
+// contextKey is the key of the options in a context.
+type contextKey struct{}
+
+// WithContext returns a copy of ctx carrying the options, after any options ctx already carries, for FormatContext
+// and ParseContext. Request handlers can set the locale, location, dialect and strictness of a request once, as in
+//
+//	ctx = strftime.WithContext(ctx, strftime.WithLocaleName(lang), strftime.WithLocation(loc))
+//
+// and leave formatting to the code they call.
+func WithContext(ctx context.Context, opts ...Option) context.Context {
+	return context.WithValue(ctx, contextKey{}, contextOptions(ctx, opts))
+}
+
+// FormatContext is like Format with the options carried by ctx, followed by opts. Unlike Format it reports the
+// errors Parse reports: an unknown locale name, an invalid format and, with WithStrict, unknown conversion
+// specifications.
+func FormatContext(ctx context.Context, format string, t time.Time, opts ...Option) (string, error) {
+	o := newOptions(contextOptions(ctx, opts))
+	if o.err != nil {
+		return "", o.err
+	}
+	p, err := compilePattern(format, o)
+	if err != nil && (o.strict || !lenient(err)) {
+		return "", err
+	}
+
+	return p.Format(t), nil
+}
+
+// ParseContext is like Parse with the options carried by ctx, followed by opts.
+func ParseContext(ctx context.Context, format, value string, opts ...Option) (time.Time, error) {
+	return Parse(format, value, contextOptions(ctx, opts)...)
+}
+
+// contextOptions returns the options carried by ctx followed by opts.
+func contextOptions(ctx context.Context, opts []Option) []Option {
+	carried, _ := ctx.Value(contextKey{}).([]Option)
+	if len(opts) == 0 {
+		return carried
+	}
+
+	return append(carried[:len(carried):len(carried)], opts...)
+}
@@ -0,0 +1,73 @@
+package strftime
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFormatContext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	ctx := WithContext(context.Background(), WithLocaleName("de_DE"), WithLocation(berlin))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		format  string
+		opts    []Option
+		want    string
+		wantErr bool
+	}{
+		{name: "No options", ctx: context.Background(), format: "%A %H:%M %Z", want: "Wednesday 04:05 UTC"},
+		{name: "Locale and location", ctx: ctx, format: "%A %H:%M %Z", want: "Mittwoch 05:05 CET"},
+		{name: "Options override the context", ctx: ctx, format: "%A %H:%M", opts: []Option{WithLocale(EnUS)}, want: "Wednesday 05:05"},
+		{name: "Nested contexts", ctx: WithContext(ctx, WithDialect(DialectICU)), format: "EEEE HH:mm", want: "Mittwoch 05:05"},
+		{name: "Unknown conversion as literal text", ctx: ctx, format: "%H:%M %Q", want: "05:05 %Q"},
+		{name: "Unknown conversion in strict mode", ctx: WithContext(ctx, WithStrict(true)), format: "%H:%M %Q", wantErr: true},
+		{name: "Unknown locale", ctx: WithContext(ctx, WithLocaleName("xx_XX")), format: "%A", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatContext(tt.ctx, tt.format, tm, tt.opts...)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("FormatContext() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	ctx := WithContext(context.Background(), WithLocation(berlin), WithStrict(true))
+
+	tests := []struct {
+		name    string
+		format  string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Value in location", format: "%F %T", value: "2021-07-03 04:05:06", want: time.Date(2021, time.July, 3, 4, 5, 6, 0, berlin)},
+		{name: "Value with offset", format: "%F %T %z", value: "2021-07-03 04:05:06 +0200", want: time.Date(2021, time.July, 3, 2, 5, 6, 0, time.UTC)},
+		{name: "Zone abbreviation of location", format: "%F %T %Z", value: "2021-07-03 04:05:06 CEST", want: time.Date(2021, time.July, 3, 4, 5, 6, 0, berlin)},
+		{name: "Strict", format: "%F %Q", value: "2021-07-03 %Q", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseContext(ctx, tt.format, tt.value)
+			if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+				t.Errorf("ParseContext() = %v, %v, want %v", got, err, tt.want)
+			}
+			if err == nil && got.Location() != berlin {
+				t.Errorf("ParseContext() location = %v, want %v", got.Location(), berlin)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package strftime
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A Dialect is a date format language: the syntax of its format strings and the meaning of their formatting
+// elements. Format and Parse read format strings in the dialect selected with the WithDialect option, DialectC by
+// default, and Convert translates format strings from one dialect to another.
+type Dialect struct {
+	name   string
+	target string
+	// compile splits a format string into literal text and conversion specifications. Formatting elements without
+	// an equivalent are reported in a *ConversionError and kept as literal text.
+	compile func(format string, l *Locale) ([]item, error)
+	// render writes compiled items in the dialect's syntax, recording the items it can not write in cerr.
+	render func(items []item, cerr *ConversionError) string
+}
+
+// String returns the name of the dialect.
+func (d *Dialect) String() string {
+	return d.name
+}
+
+// DialectC is the strftime(3) dialect of this package, described in the package documentation. It combines the
+// conversion specifications of C99 with those of glibc and BSD and has the extensions %J, %K, %o and %N.
+var DialectC = &Dialect{
+	name:   "C",
+	target: "a strftime format",
+	compile: func(format string, l *Locale) ([]item, error) {
+		return packageSyntax.appendItems(nil, format, l, 0)
+	},
+	render: renderC,
+}
+
+// DialectC99 is the strftime dialect of ISO C99: no flags and field widths, and %e is padded with a space. %c writes
+// the date and time of the C locale, %a %b %e %H:%M:%S %Y.
+var DialectC99 = syntaxDialect("C99", "a C99 strftime format", &syntax{
+	specs:     c99Specs,
+	modifiers: true,
+	pads:      map[rune]byte{'e': '_', 'C': '0', 'G': '0', 'V': '0'},
+	formats:   map[rune]string{'c': c99DateTime},
+})
+
+// DialectPOSIX is the strftime dialect of POSIX.1-2008, which adds to C99 the 0 and + flags and field widths. The +
+// flag pads with zeros and writes a plus sign before a year with more than four digits.
+var DialectPOSIX = syntaxDialect("POSIX", "a POSIX strftime format", &syntax{
+	specs:     c99Specs,
+	flags:     "0+",
+	width:     true,
+	modifiers: true,
+	pads:      map[rune]byte{'e': '_', 'C': '0', 'G': '0', 'V': '0'},
+	formats:   map[rune]string{'c': c99DateTime},
+})
+
+// DialectGlibc is the strftime dialect of the GNU C library. It adds to C99 the flags -, _, 0, ^ and #, field widths
+// and %k, %l, %P and %s. %e, %k and %l are padded with spaces and %c writes the date and time of the C locale.
+var DialectGlibc = syntaxDialect("glibc", "a glibc strftime format", &syntax{
+	specs:     c99Specs + "klPs",
+	flags:     "-_0^#",
+	width:     true,
+	modifiers: true,
+	pads:      map[rune]byte{'e': '_', 'k': '_', 'l': '_', 'C': '0', 'G': '0', 'V': '0'},
+	formats:   map[rune]string{'c': c99DateTime},
+})
+
+// DialectBSD is the strftime dialect of FreeBSD and macOS. It adds to C99 the flags -, _ and 0 and %k, %l, %s, %v
+// (%e-%b-%Y) and %+ (the format of date(1)). Unknown conversion specifications are written without their %.
+var DialectBSD = syntaxDialect("BSD", "a BSD strftime format", &syntax{
+	specs:       c99Specs + "klsv+",
+	flags:       "-_0",
+	modifiers:   true,
+	pads:        map[rune]byte{'e': '_', 'k': '_', 'l': '_', 'C': '0', 'G': '0', 'V': '0'},
+	formats:     map[rune]string{'+': "%a %b %_e %H:%M:%S %Z %Y", 'v': "%_e-%b-%Y"},
+	dropPercent: true,
+})
+
+// DialectPython is the dialect of the directives documented for the Python datetime strftime and strptime methods.
+// It has no flags and field widths, adds %f for microseconds and %:z and lacks %C, %D, %e, %F, %h, %n, %r, %R, %t
+// and %T. %c writes the date and time of the C locale.
+var DialectPython = syntaxDialect("Python", "a Python strftime format", &syntax{
+	specs:   "aAwdbBmyYHIpMSfzZjUWcxXGuV",
+	colons:  true,
+	pads:    map[rune]byte{'G': '0', 'V': '0'},
+	aliases: map[rune]item{'f': {spec: 'N', width: 6}},
+	formats: map[rune]string{'c': c99DateTime},
+})
+
+// DialectRuby is the dialect of the Ruby Time#strftime method. It adds to C99 the flags -, _, 0, ^ and #, field
+// widths, %:z and %::z, %k, %l, %P, %s, %L (milliseconds), %N (fractional seconds), %v (%e-%^b-%4Y) and %+. %c, %x,
+// %X and %r do not depend on the locale and the E and O modifiers are ignored.
+var DialectRuby = syntaxDialect("Ruby", "a Ruby strftime format", &syntax{
+	specs:           c99Specs + "klPsLNv+",
+	flags:           "-_0^#",
+	width:           true,
+	modifiers:       true,
+	colons:          true,
+	ignoreModifiers: true,
+	pads:            map[rune]byte{'e': '_', 'k': '_', 'l': '_', 'C': '0', 'G': '0', 'V': '0'},
+	aliases:         map[rune]item{'L': {spec: 'N', width: 3}},
+	formats: map[rune]string{
+		'c': c99DateTime, 'x': "%m/%d/%y", 'X': "%H:%M:%S", 'r': "%I:%M:%S %p",
+		'+': "%a %b %_e %H:%M:%S %Z %Y", 'v': "%_e-%^b-%4Y",
+	},
+})
+
+// c99Specs holds the conversion characters of C99.
+const c99Specs = "aAbBcCdDeFgGhHIjmMnprRStTuUVwWxXyYzZ"
+
+// c99DateTime is the %c format of the C locale.
+const c99DateTime = "%a %b %_e %H:%M:%S %Y"
+
+func syntaxDialect(name, target string, s *syntax) *Dialect {
+	return &Dialect{
+		name:   name,
+		target: target,
+		compile: func(format string, l *Locale) ([]item, error) {
+			return s.appendItems(nil, format, l, 0)
+		},
+		render: func(items []item, cerr *ConversionError) string {
+			return s.render(items, cerr)
+		},
+	}
+}
+
+// Convert translates a format string from one dialect to another. Composite conversion specifications such as %c
+// are expanded using the en_US locale. It returns a *ConversionError listing the formatting elements without an
+// equivalent in the target dialect.
+func Convert(format string, from, to *Dialect) (string, error) {
+	items, err := from.compile(format, EnUS)
+	if err != nil && !lenient(err) {
+		if cerr, ok := err.(*ConversionError); ok {
+			cerr.Target = to.target
+		}
+		return "", err
+	}
+
+	cerr := &ConversionError{Format: format, Target: to.target}
+	s := to.render(items, cerr)
+	if !cerr.empty() {
+		return "", cerr
+	}
+
+	return s, nil
+}
+
+// lenient reports whether a compile error leaves the compiled items usable, as the unknown conversion
+// specifications reported by a *FormatError are kept as literal text.
+func lenient(err error) bool {
+	_, ok := err.(*FormatError)
+	return ok
+}
+
+// renderC writes the items in strftime syntax. An offset writing Z for UTC becomes the numeric offset of %z or %:z,
+// which writes +00:00 for UTC, as FromLayout does with the Z07:00 layout element.
+func renderC(items []item, cerr *ConversionError) string {
+	items = append([]item(nil), items...)
+	for i, it := range items {
+		switch {
+		case it.spec == specOffsetZ:
+			items[i] = item{spec: 'z', colons: it.colons}
+		case extraSpecNames[it.spec] != "":
+			cerr.Specs = append(cerr.Specs, it.String())
+		}
+	}
+
+	return formatString(items)
+}
+
+// Conversions of other dialects that have no strftime conversion specification. They take part in compiled formats
+// like any other specification but can not be written in strftime syntax.
+const (
+	specDaySuffix      rune = unicode.MaxRune + 1 + iota // English ordinal suffix of the day of the month
+	specMondayWeek                                       // week of the year, weeks starting on Monday, 0 to 53
+	specSundayWeek                                       // week of the week based year, weeks starting on Sunday
+	specSundayWeekYear                                   // week based year of specSundayWeek
+	specOffsetZ                                          // Z for UTC, the offset as in %z (%:z with colons) otherwise
+	specYearRR                                           // year in century, read in a window around the current year
+	specYearRRRR                                         // year, two digits read as specYearRR
+	specUnixMilli                                        // milliseconds since the Epoch
+	specUTC                                              // no output, converts the time to UTC for the items after it
+)
+
+// extraSpecNames holds the descriptions of the conversions without a strftime conversion specification.
+var extraSpecNames = map[rune]string{
+	specDaySuffix:      "day of month suffix",
+	specMondayWeek:     "week of year with Monday as first day (0-53)",
+	specSundayWeek:     "week of year with Sunday as first day (1-53)",
+	specSundayWeekYear: "year of week with Sunday as first day",
+	specOffsetZ:        "UTC offset or Z",
+	specYearRR:         "year in century with Oracle RR window",
+	specYearRRRR:       "year with Oracle RR window",
+	specUnixMilli:      "milliseconds since the Epoch",
+	specUTC:            "conversion to UTC",
+}
+
+// canonical returns the equivalent of a conversion specification that does not depend on this package's unpadded
+// variants: %e is %-d, %k is %-H and %l is %-I.
+func canonical(it item) item {
+	for _, v := range [...]struct{ from, to rune }{{'e', 'd'}, {'k', 'H'}, {'l', 'I'}} {
+		if it.spec == v.from {
+			it.spec, it.pad = v.to, padding(it.pad, numberSpecs[v.from].pad)
+		}
+	}
+
+	return it
+}
+
+// sameItem reports whether two items produce the same output.
+func sameItem(a, b item) bool {
+	if a.spec == 0 || b.spec == 0 {
+		return a.spec == b.spec && a.text == b.text
+	}
+
+	return a.casing == b.casing && specString(canonical(a)) == specString(canonical(b))
+}
+
+// matchSpecs returns the key of the longest entry of specs whose items start items and the number of items it
+// covers. Among entries of the same length the smallest key wins.
+func matchSpecs(items []item, specs map[string][]item) (key string, n int) {
+	for k, entry := range specs {
+		if len(entry) > len(items) || len(entry) < n || len(entry) == n && k > key {
+			continue
+		}
+		match := true
+		for i := range entry {
+			if !sameItem(entry[i], items[i]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			key, n = k, len(entry)
+		}
+	}
+
+	return key, n
+}
+
+// renderSpecs writes items using the formatting elements of specs, which are matched longest first. Literal text is
+// written by quote.
+func renderSpecs(items []item, specs map[string][]item, quote func(string) string, cerr *ConversionError) string {
+	var b strings.Builder
+	for len(items) > 0 {
+		if items[0].spec == 0 {
+			b.WriteString(quote(items[0].text))
+			items = items[1:]
+			continue
+		}
+
+		key, n := matchSpecs(items, specs)
+		if n == 0 {
+			cerr.Specs = append(cerr.Specs, items[0].String())
+			items = items[1:]
+			continue
+		}
+		b.WriteString(key)
+		items = items[n:]
+	}
+
+	return b.String()
+}
+
+// specTable compiles formatting elements given by their strftime equivalent.
+func specTable(specs map[string]string) map[string][]item {
+	table := make(map[string][]item, len(specs))
+	for key, spec := range specs {
+		table[key] = compile(spec, EnUS)
+	}
+
+	return table
+}
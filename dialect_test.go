@@ -0,0 +1,110 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_dialects(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)
+	tests := []struct {
+		name    string
+		format  string
+		dialect *Dialect
+		want    string
+	}{
+		{name: "Package quirks", format: "%e|%k|%C|%V|%+", dialect: DialectC, want: "3|4|20|5|Wed Feb 03 04:05:06 UTC 2021"},
+		{name: "Case flags", format: "%^a %^B %#b %#p %#Z", dialect: DialectC, want: "WED FEBRUARY FEB am utc"},
+		{name: "C99 space padded day", format: "%e|%k|%-d", dialect: DialectC99, want: " 3|%k|%-d"},
+		{name: "POSIX flags and widths", format: "%+6Y|%04C|%+F", dialect: DialectPOSIX, want: "002021|0020|2021-02-03"},
+		{name: "POSIX plus sign for long years", format: "%+4Y", dialect: DialectPOSIX, want: "2021"},
+		{name: "glibc padding", format: "%e|%k|%l|%C|%V|%10Y|%-d", dialect: DialectGlibc, want: " 3| 4| 4|20|05|0000002021|3"},
+		{name: "glibc extensions", format: "%P %^a %#Z %s", dialect: DialectGlibc, want: "am WED utc 1612325106"},
+		{name: "glibc without BSD and GNU date extensions", format: "%+ %N %:z", dialect: DialectGlibc, want: "%+ %N %:z"},
+		{name: "BSD date and VMS formats", format: "%+|%v", dialect: DialectBSD, want: "Wed Feb  3 04:05:06 UTC 2021| 3-Feb-2021"},
+		{name: "BSD unknown conversions", format: "%P|%q|%^a", dialect: DialectBSD, want: "P|q|^a"},
+		{name: "Python microseconds and offset", format: "%Y-%m-%dT%H:%M:%S.%f%:z", dialect: DialectPython, want: "2021-02-03T04:05:06.789000+00:00"},
+		{name: "Python undocumented directives", format: "%e %-d %s", dialect: DialectPython, want: "%e %-d %s"},
+		{name: "Ruby fractions", format: "%L %6L %3N %N", dialect: DialectRuby, want: "789 789000 789 789000000"},
+		{name: "Ruby fixed formats", format: "%c|%v|%r", dialect: DialectRuby, want: "Wed Feb  3 04:05:06 2021| 3-FEB-2021|04:05:06 AM"},
+		{name: "Ruby flags", format: "%-l%P %^B %::z %Ey", dialect: DialectRuby, want: "4am FEBRUARY +00:00:00 21"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tm, WithDialect(tt.dialect)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat_dialectDateTime(t *testing.T) {
+	tm := time.Date(2021, time.January, 3, 12, 0, 0, 0, time.UTC)
+	for _, d := range []*Dialect{DialectC99, DialectPOSIX, DialectGlibc, DialectPython, DialectRuby} {
+		if got, want := Format("%c", tm, WithDialect(d)), "Sun Jan  3 12:00:00 2021"; got != want {
+			t.Errorf("Format() with %v = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestParse_dialects(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		dialect *Dialect
+		value   string
+		want    time.Time
+	}{
+		{name: "Unknown conversions are literal text", format: "%Y%q", dialect: DialectC, value: "2021%q", want: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Python microseconds", format: "%Y-%m-%d %H:%M:%S.%f", dialect: DialectPython, value: "2021-02-03 04:05:06.789000", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+		{name: "Ruby milliseconds", format: "%F %T.%L", dialect: DialectRuby, value: "2021-02-03 04:05:06.789", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+		{name: "BSD date format", format: "%+", dialect: DialectBSD, value: "Wed Feb  3 04:05:06 UTC 2021", want: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, tt.value, WithDialect(tt.dialect))
+			if err != nil || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_dialects(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "Package unpadded day to glibc", format: "%e.%m. %k:%M", from: DialectC, to: DialectGlibc, want: "%-e.%m. %-k:%M"},
+		{name: "glibc padded day to the package", format: "%e %k %C", from: DialectGlibc, to: DialectC, want: "%_e %_k %0C"},
+		{name: "glibc to BSD", format: "%e %-d %s", from: DialectGlibc, to: DialectBSD, want: "%e %-d %s"},
+		{name: "Package to C99", format: "%a %d %b %Y %T", from: DialectC, to: DialectC99, want: "%a %d %b %Y %H:%M:%S"},
+		{name: "Package extensions to C99", format: "%-d %k %P %^a", from: DialectC, to: DialectC99, wantSpecs: []string{"%-d", "%k", "%P", "%^a"}},
+		{name: "Python to Ruby", format: "%H:%M:%S.%f", from: DialectPython, to: DialectRuby, want: "%H:%M:%S.%6N"},
+		{name: "Ruby to Python", format: "%S.%6N %S.%L", from: DialectRuby, to: DialectPython, wantSpecs: []string{"%3N"}},
+		{name: "Ruby VMS date to the package", format: "%v", from: DialectRuby, to: DialectC, want: "%_e-%^b-%4Y"},
+		{name: "Package to Python", format: "%s %e", from: DialectC, to: DialectPython, wantSpecs: []string{"%s", "%e"}},
+		{name: "POSIX year flags to glibc", format: "%+6Y", from: DialectPOSIX, to: DialectGlibc, wantSpecs: []string{"%+6Y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
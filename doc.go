@@ -10,12 +10,70 @@
 //
 // This package attempts to comply with the C strftime(3) function closely as reasonably possible. The format
 // specification strings contain special character sequences called conversion specifications. Conversion specifications
-// are prefixed by the % character. At this time all conversion specifications are supported with the exception of
-// modifiers.
+// are prefixed by the % character. All conversion specifications are supported, along with the glibc padding flags
+// (%-d for no padding, %_d for space padding and %0e for zero padding) and the E and O modifiers. The E modifier
+// selects the locale's era based representation for %Ec, %EC, %Ex, %EX, %Ey and %EY, falling back to the Gregorian
+// calendar for locales without eras. Alternative digits are not supported, so the O modifier has no effect.
+//
+// The glibc case flags ^ (upper case) and # (swapped case) are supported as well. A decimal field width may follow the
+// flags, as in %4Y. The GNU extensions %N (nanoseconds, with the width giving the number of digits, as in %3N for
+// milliseconds), %:z (+hh:mm) and %::z (+hh:mm:ss) are also supported.
+//
+// In addition the package provides %K, the week of the year, %J, the week based year of %K, and %o, the number of the
+// day within the week (1 to 7), following the first weekday and minimal days in the first week of the locale. For a
+// locale whose weeks start on Monday and need 4 days in the new year, %J and %K are the ISO 8601 week based year and
+// week number of %G and %V.
+//
+// Format Conversion
+//
+// ToLayout and FromLayout convert between strftime formats and Go time package layouts, ToICU and FromICU between
+// strftime formats and ICU or Java DateTimeFormatter patterns. Parts without an equivalent are reported in a
+// *ConversionError.
+//
+// Dialects
+//
+// Format and Parse also read format strings of other format languages, selected with the WithDialect option:
+// DialectMySQL for the formats of the MySQL DATE_FORMAT and STR_TO_DATE functions, DialectPostgreSQL for the templates
+// of the PostgreSQL to_char and to_timestamp functions, DialectOracle for the format models of the Oracle TO_CHAR and
+// TO_DATE functions, DialectICU for ICU and Java DateTimeFormatter patterns, DialectElasticsearch for the named
+// formats and patterns of Elasticsearch date fields, DialectPHP for PHP date formats, DialectMoment and DialectLuxon
+// for the tokens of the moment.js and Luxon libraries and DialectDotNet for .NET custom and standard date and time
+// format strings. Convert translates format strings between dialects. CompileElasticsearch compiles an Elasticsearch
+// format with || separated alternatives and optional sections to Patterns, which parse a value with the first matching
+// alternative.
+//
+// The strftime implementations disagree on the supported conversion specifications, flags and padding. DialectC is the
+// dialect of this package and accepts all of them, DialectC99, DialectPOSIX, DialectGlibc, DialectBSD, DialectPython
+// and DialectRuby reproduce the behavior of the respective implementation. Compile compiles a format string once for
+// repeated use with a locale and dialect and reports unknown conversion specifications, which Format and Parse read as
+// literal text, in a *FormatError. The WithStrict option makes Parse report them as well. The WithLocation option
+// sets the time zone times are formatted in and values without time zone information are parsed in.
+//
+// Encoding
+//
+// Time wraps a time.Time that is encoded in JSON as a string formatted with a Pattern. MarshalJSON and UnmarshalJSON
+// encode and decode structs as the encoding/json package does, formatting and parsing the time.Time fields with a
+// strftime struct tag, as in `strftime:"%Y-%m-%d"`, with the format of the tag. Formatted is a time.Time encoded as
+// text in the format supplied by its type parameter, a FormatSpec, for the libraries using the encoding.TextMarshaler
+// and encoding.TextUnmarshaler interfaces. SQLTime is a nullable time stored as text in a database column,
+// implementing the sql.Scanner and driver.Valuer interfaces. Layout is a format string in a configuration file,
+// compiled and validated when the configuration is loaded with the options given to NewLayout.
+//
+// Integration
+//
+// FuncMap returns the template functions strftime, strftimeIn, strftimeLocale and strptime for the text/template and
+// html/template packages. ReplaceAttr and NewSlogHandler format the times of log/slog records with a Pattern, and a
+// Writer prefixes every line written to it with the time formatted with a Pattern. TimeVar defines command line flags
+// holding a time, TimeValue is the flag.Value behind them, also usable with the spf13/pflag package. T returns a
+// Printable, which prints a time in a strftime format with the fmt package, honoring the width, precision and - flag
+// of the verb, as in fmt.Printf("%-20v|", strftime.T(t, "%F %T")). WithContext attaches options to a context.Context,
+// such as the locale and location of a request, for FormatContext and ParseContext.
 //
 // Localization
 //
-// Support for localization is currently mixed. Conversion specifications for individual time fields should be fully
-// localized. However, conversion specifications that map to different timestamps formats depending on locale (for
-// example %c, %x, and %X) only support the en_US localization.
+// Names of days and months, the AM/PM designation and the preferred representations used by %c, %x, %X and %r are
+// taken from a Locale, selected with the WithLocale option. The default locale is en_US. When parsing, names are
+// matched without regard to case, using Unicode case folding for localized names, and %a, %A, %b, %B and %h accept
+// both the full and the abbreviated form. Locales are registered by name with RegisterLocale, which also allows
+// overriding the shipped locales, and selected by name with the WithLocaleName option.
 package strftime
@@ -0,0 +1,160 @@
+package strftime
+
+import "strings"
+
+// DialectDotNet is the language of the .NET custom date and time format strings, as in yyyy-MM-ddTHH:mm:ss.fffK, and
+// of the standard format strings d, D, f, F, g, G, m, M, o, O, r, R, s, t, T, u, U, y and Y of the invariant culture.
+// Text in single or double quotes is literal, a backslash escapes the character following it and a % before a
+// specifier is ignored. The date and time separators / and : are the invariant culture's. The R, r, u and U formats
+// convert the time to UTC, which has no equivalent in other dialects. Specifiers without a strftime equivalent, such
+// as the F fraction, the era (g), the single letter designator (t) and the offsets z and zz, are reported as errors.
+var DialectDotNet = &Dialect{
+	name:    ".NET",
+	target:  "a .NET format",
+	compile: compileDotNet,
+	render:  renderDotNet,
+}
+
+// dotnetSpecs holds the compiled equivalents of the .NET custom format specifiers.
+var dotnetSpecs = func() map[string][]item {
+	specs := specTable(map[string]string{
+		"d": "%-d", "dd": "%d", "ddd": "%a", "dddd": "%A",
+		"f": "%1N", "ff": "%2N", "fff": "%3N", "ffff": "%4N", "fffff": "%5N", "ffffff": "%6N", "fffffff": "%7N",
+		"h": "%-I", "hh": "%I", "H": "%-H", "HH": "%H", "m": "%-M", "mm": "%M", "s": "%-S", "ss": "%S",
+		"M": "%-m", "MM": "%m", "MMM": "%b", "MMMM": "%B", "tt": "%p",
+		"y": "%-y", "yy": "%y", "yyy": "%3Y", "yyyy": "%Y", "zzz": "%:z",
+	})
+	specs["K"] = []item{{spec: specOffsetZ, colons: 1}}
+
+	return specs
+}()
+
+// dotnetLongest holds the number of repetitions above which a .NET format specifier reads as the longest form.
+var dotnetLongest = map[byte]int{'d': 4, 'h': 2, 'H': 2, 'm': 2, 'M': 4, 's': 2, 't': 2, 'z': 3, 'K': 1}
+
+// dotnetStandard holds the custom format strings equivalent to the standard format strings of the invariant culture.
+var dotnetStandard = map[string]string{
+	"d": "MM/dd/yyyy", "D": "dddd, dd MMMM yyyy", "f": "dddd, dd MMMM yyyy HH:mm", "F": "dddd, dd MMMM yyyy HH:mm:ss",
+	"g": "MM/dd/yyyy HH:mm", "G": "MM/dd/yyyy HH:mm:ss", "m": "MMMM dd", "M": "MMMM dd",
+	"o": "yyyy'-'MM'-'dd'T'HH':'mm':'ss'.'fffffffK", "O": "yyyy'-'MM'-'dd'T'HH':'mm':'ss'.'fffffffK",
+	"r": "ddd, dd MMM yyyy HH':'mm':'ss 'GMT'", "R": "ddd, dd MMM yyyy HH':'mm':'ss 'GMT'",
+	"s": "yyyy'-'MM'-'dd'T'HH':'mm':'ss", "t": "HH:mm", "T": "HH:mm:ss",
+	"u": "yyyy'-'MM'-'dd HH':'mm':'ss'Z'", "U": "dddd, dd MMMM yyyy HH:mm:ss", "y": "yyyy MMMM", "Y": "yyyy MMMM",
+}
+
+// dotnetSpecifiers holds the letters of the .NET custom format specifiers.
+const dotnetSpecifiers = "dfFghHKmMstyz"
+
+func compileDotNet(f string, _ *Locale) ([]item, error) {
+	cerr := &ConversionError{Format: f, Target: "a strftime format"}
+	var items []item
+	if len(f) == 1 {
+		standard, found := dotnetStandard[f]
+		if !found {
+			cerr.Specs = append(cerr.Specs, f)
+			return []item{{text: f}}, cerr
+		}
+		if strings.Contains("RruU", f) {
+			// The universal formats convert the time to UTC.
+			items = append(items, item{spec: specUTC})
+		}
+		f = standard
+	}
+
+	for i := 0; i < len(f); {
+		c := f[i]
+		switch {
+		case c == '\'' || c == '"':
+			text, n := unquoteDotNet(f[i:])
+			items = appendText(items, text)
+			i += n
+		case c == '\\' && i+1 < len(f):
+			items = appendText(items, f[i+1:i+2])
+			i += 2
+		case c == '%':
+			i++
+		case strings.IndexByte(dotnetSpecifiers, c) >= 0:
+			n := 1
+			for i+n < len(f) && f[i+n] == c && dotnetLongest[c] != 1 {
+				n++
+			}
+			token := f[i : i+n]
+			i += n
+
+			var spec []item
+			switch {
+			case c == 'y' && n > 4:
+				spec = []item{{spec: 'Y', width: n}}
+			case dotnetLongest[c] > 0 && n > dotnetLongest[c]:
+				spec = dotnetSpecs[strings.Repeat(string(c), dotnetLongest[c])]
+			default:
+				spec = dotnetSpecs[token]
+			}
+			if spec == nil {
+				cerr.Specs = append(cerr.Specs, token)
+				items = appendText(items, token)
+				continue
+			}
+			items = append(items, spec...)
+		default:
+			items = appendText(items, f[i:i+1])
+			i++
+		}
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+// unquoteDotNet reads the quoted text at the start of s, which starts with a single or double quote, and returns the
+// literal text and the number of bytes read. A backslash escapes the character following it. An unterminated quote
+// extends to the end of s.
+func unquoteDotNet(s string) (string, int) {
+	var b strings.Builder
+	i := 1
+	for i < len(s) {
+		switch {
+		case s[i] == s[0]:
+			return b.String(), i + 1
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+
+	return b.String(), i
+}
+
+func renderDotNet(items []item, cerr *ConversionError) string {
+	specs := make(map[string][]item, len(dotnetSpecs)+5)
+	for key, spec := range dotnetSpecs {
+		specs[key] = spec
+	}
+	for n := 5; n <= 9; n++ {
+		specs[strings.Repeat("y", n)] = []item{{spec: 'Y', width: n}}
+	}
+
+	s := renderSpecs(items, specs, quoteDotNet, cerr)
+	if len(s) == 1 {
+		// A single character is a standard format string, a % marks a custom format specifier.
+		return "%" + s
+	}
+
+	return s
+}
+
+// quoteDotNet puts literal text containing ASCII letters or characters with a meaning in custom format strings in
+// single quotes, escaping single quotes and backslashes with a backslash.
+func quoteDotNet(text string) string {
+	if strings.IndexFunc(text, func(r rune) bool {
+		return r < 0x80 && (isASCIILetter(byte(r)) || strings.ContainsRune(`%\"'`, r))
+	}) < 0 {
+		return text
+	}
+
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(text) + "'"
+}
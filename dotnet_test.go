@@ -0,0 +1,98 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_dotNet(t *testing.T) {
+	utc := time.Date(2021, time.February, 3, 16, 5, 6, 789000000, time.UTC)
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "Custom format with offset", format: "yyyy-MM-ddTHH:mm:ss.fffK", t: utc.In(time.FixedZone("", 2*60*60)), want: "2021-02-03T18:05:06.789+02:00"},
+		{name: "Custom format in UTC", format: "yyyy-MM-ddTHH:mm:ss.fffK", t: utc, want: "2021-02-03T16:05:06.789Z"},
+		{name: "Names", format: "ddd, dd MMM yyyy", t: utc, want: "Wed, 03 Feb 2021"},
+		{name: "Quotes, escapes and the 12-hour clock", format: `'Day' d \o\f MMMM, h:mm tt`, t: utc, want: "Day 3 of February, 4:05 PM"},
+		{name: "Single custom specifier", format: "%d", t: utc, want: "3"},
+		{name: "Round-trip standard format", format: "o", t: utc, want: "2021-02-03T16:05:06.7890000Z"},
+		{name: "RFC 1123 standard format", format: "R", t: utc, want: "Wed, 03 Feb 2021 16:05:06 GMT"},
+		{name: "RFC 1123 standard format of a time outside UTC", format: "r", t: utc.In(time.FixedZone("", 60*60)), want: "Wed, 03 Feb 2021 16:05:06 GMT"},
+		{name: "Universal full standard format", format: "U", t: utc.In(time.FixedZone("", -5*60*60)), want: "Wednesday, 03 February 2021 16:05:06"},
+		{name: "Full standard format keeps the location", format: "F", t: utc.In(time.FixedZone("", -5*60*60)), want: "Wednesday, 03 February 2021 11:05:06"},
+		{name: "Sortable standard format", format: "s", t: utc, want: "2021-02-03T16:05:06"},
+		{name: "Universal sortable standard format", format: "u", t: utc.In(time.FixedZone("", 2*60*60)), want: "2021-02-03 16:05:06Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectDotNet)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_dotNet(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		value  string
+		opts   []Option
+		want   time.Time
+	}{
+		{name: "UTC designator", format: "yyyy-MM-ddTHH:mm:ss.fffK", value: "2021-02-03T16:05:06.789Z", want: time.Date(2021, time.February, 3, 16, 5, 6, 789000000, time.UTC)},
+		{name: "Offset", format: "yyyy-MM-ddTHH:mm:ssK", value: "2021-02-03T18:05:06+02:00", want: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC)},
+		{name: "Standard format", format: "R", value: "Wed, 03 Feb 2021 16:05:06 GMT", want: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC)},
+		{name: "Universal standard format read in UTC", format: "u", value: "2021-02-03 16:05:06Z", opts: []Option{WithLocation(time.FixedZone("", 60*60))}, want: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, tt.value, append(tt.opts, WithDialect(DialectDotNet))...)
+			if err != nil || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_dotNet(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: ".NET to strftime", format: "yyyy-MM-ddTHH:mm:ss.fffzzz", from: DialectDotNet, to: DialectC, want: "%Y-%m-%dT%H:%M:%S.%3N%:z"},
+		{name: ".NET long specifiers to strftime", format: "dddddd MMMMM yyyyyy", from: DialectDotNet, to: DialectC, want: "%A %B %6Y"},
+		{name: ".NET specifiers without an equivalent", format: "FFF gg t zz", from: DialectDotNet, to: DialectC, wantSpecs: []string{"FFF", "gg", "t", "zz"}},
+		{name: "Unknown standard format", format: "x", from: DialectDotNet, to: DialectC, wantSpecs: []string{"x"}},
+		{name: "Universal standard format", format: "R", from: DialectDotNet, to: DialectC, wantSpecs: []string{"<conversion to UTC>"}},
+		{name: "strftime to .NET", format: "%Y-%m-%dT%H:%M:%S", from: DialectC, to: DialectDotNet, want: "yyyy-MM-dd'T'HH:mm:ss"},
+		{name: "strftime literals to .NET", format: "%d. %B %Y, %k h 100%%", from: DialectC, to: DialectDotNet, want: "dd. MMMM yyyy, H' h 100%'"},
+		{name: "strftime single specifier to .NET", format: "%e", from: DialectC, to: DialectDotNet, want: "%d"},
+		{name: "strftime specifications without an equivalent", format: "%s %j", from: DialectC, to: DialectDotNet, wantSpecs: []string{"%s", "%j"}},
+		{name: ".NET to moment", format: "ddd, dd MMM yyyy", from: DialectDotNet, to: DialectMoment, want: "ddd, DD MMM YYYY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package strftime
+
+import (
+	"errors"
+	"strings"
+)
+
+// DialectElasticsearch is the date format language of Elasticsearch date field mappings: the built-in named formats,
+// such as strict_date_optional_time, basic_date_time and epoch_millis, and Java DateTimeFormatter patterns as read by
+// DialectICU.
+//
+// A format can hold several alternatives, separated by ||, and patterns can have optional sections in square
+// brackets. Format uses the first alternative. CompileElasticsearch compiles a format to one pattern per alternative
+// for parsing values the way Elasticsearch does, the dialect itself reports formats with more than one alternative,
+// including the named formats with optional parts, as errors.
+var DialectElasticsearch = &Dialect{
+	name:    "Elasticsearch",
+	target:  "an Elasticsearch date format",
+	compile: compileElasticsearch,
+	render:  renderICU,
+}
+
+// elasticsearchFormats holds the built-in named formats of Elasticsearch as Java patterns. Each also exists with the
+// strict_ prefix, which Elasticsearch uses to require the exact number of digits. Field widths are not enforced when
+// parsing, so both variants read the same values here.
+var elasticsearchFormats = map[string]string{
+	"date_optional_time":                "yyyy[-MM[-dd['T'HH[:mm[:ss[.SSS]]][XXX]]]]",
+	"date_optional_time_nanos":          "yyyy[-MM[-dd['T'HH[:mm[:ss[.SSSSSSSSS]]][XXX]]]]",
+	"basic_date":                        "yyyyMMdd",
+	"basic_date_time":                   "yyyyMMdd'T'HHmmss.SSSXXX",
+	"basic_date_time_no_millis":         "yyyyMMdd'T'HHmmssXXX",
+	"basic_ordinal_date":                "yyyyDDD",
+	"basic_ordinal_date_time":           "yyyyDDD'T'HHmmss.SSSXXX",
+	"basic_ordinal_date_time_no_millis": "yyyyDDD'T'HHmmssXXX",
+	"basic_time":                        "HHmmss.SSSXXX",
+	"basic_time_no_millis":              "HHmmssXXX",
+	"basic_t_time":                      "'T'HHmmss.SSSXXX",
+	"basic_t_time_no_millis":            "'T'HHmmssXXX",
+	"basic_week_date":                   "YYYY'W'wwe",
+	"basic_week_date_time":              "YYYY'W'wwe'T'HHmmss.SSSXXX",
+	"basic_week_date_time_no_millis":    "YYYY'W'wwe'T'HHmmssXXX",
+	"date":                              "yyyy-MM-dd",
+	"date_hour":                         "yyyy-MM-dd'T'HH",
+	"date_hour_minute":                  "yyyy-MM-dd'T'HH:mm",
+	"date_hour_minute_second":           "yyyy-MM-dd'T'HH:mm:ss",
+	"date_hour_minute_second_fraction":  "yyyy-MM-dd'T'HH:mm:ss.SSS",
+	"date_hour_minute_second_millis":    "yyyy-MM-dd'T'HH:mm:ss.SSS",
+	"date_time":                         "yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+	"date_time_no_millis":               "yyyy-MM-dd'T'HH:mm:ssXXX",
+	"hour":                              "HH",
+	"hour_minute":                       "HH:mm",
+	"hour_minute_second":                "HH:mm:ss",
+	"hour_minute_second_fraction":       "HH:mm:ss.SSS",
+	"hour_minute_second_millis":         "HH:mm:ss.SSS",
+	"ordinal_date":                      "yyyy-DDD",
+	"ordinal_date_time":                 "yyyy-DDD'T'HH:mm:ss.SSSXXX",
+	"ordinal_date_time_no_millis":       "yyyy-DDD'T'HH:mm:ssXXX",
+	"time":                              "HH:mm:ss.SSSXXX",
+	"time_no_millis":                    "HH:mm:ssXXX",
+	"t_time":                            "'T'HH:mm:ss.SSSXXX",
+	"t_time_no_millis":                  "'T'HH:mm:ssXXX",
+	"week_date":                         "YYYY-'W'ww-e",
+	"week_date_time":                    "YYYY-'W'ww-e'T'HH:mm:ss.SSSXXX",
+	"week_date_time_no_millis":          "YYYY-'W'ww-e'T'HH:mm:ssXXX",
+	"weekyear":                          "YYYY",
+	"weekyear_week":                     "YYYY-'W'ww",
+	"weekyear_week_day":                 "YYYY-'W'ww-e",
+	"year":                              "yyyy",
+	"year_month":                        "yyyy-MM",
+	"year_month_day":                    "yyyy-MM-dd",
+}
+
+// elasticsearchEpochs holds the named formats of timestamps since the Epoch, which have no pattern.
+var elasticsearchEpochs = map[string]item{
+	"epoch_millis": {spec: specUnixMilli},
+	"epoch_second": {spec: 's'},
+}
+
+// maxAlternatives bounds the number of alternatives a format expands to, as every optional section doubles it.
+const maxAlternatives = 256
+
+// CompileElasticsearch compiles an Elasticsearch date format, such as strict_date_optional_time||epoch_millis, to
+// one pattern per alternative, expanding optional sections and the named formats. Parsing with the returned Patterns
+// tries the alternatives in the order Elasticsearch does, formatting uses the first. The named formats with
+// milliseconds also read up to nine fractional digits, as in Elasticsearch.
+func CompileElasticsearch(format string, opts ...Option) (Patterns, error) {
+	alternatives, err := elasticsearchAlternatives(format, true)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts[:len(opts):len(opts)], WithDialect(DialectElasticsearch))
+	patterns := make(Patterns, 0, len(alternatives))
+	for _, alternative := range alternatives {
+		p, err := Compile(alternative, opts...)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, p)
+	}
+
+	return patterns, nil
+}
+
+func compileElasticsearch(format string, _ *Locale) ([]item, error) {
+	alternatives, err := elasticsearchAlternatives(format, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern := alternatives[0]
+	var items []item
+	if it, found := elasticsearchEpochs[pattern]; found {
+		items = []item{it}
+	} else {
+		items, err = compileLetters(pattern, icuReserved, icuLetterItems)
+	}
+	if len(alternatives) == 1 {
+		return items, err
+	}
+
+	cerr := &ConversionError{Format: format, Target: "a strftime format"}
+	if errors.As(err, &cerr) {
+		cerr.Format = format
+	}
+	cerr.Specs = append(cerr.Specs, format)
+
+	return items, cerr
+}
+
+// elasticsearchAlternatives splits a format at || and returns the Java patterns and epoch formats of its
+// alternatives, with the named formats replaced by their patterns and the optional sections expanded. With
+// fractions, the named formats with milliseconds are followed by variants reading up to nine fractional digits.
+func elasticsearchAlternatives(format string, fractions bool) ([]string, error) {
+	var alternatives []string
+	for _, part := range strings.Split(format, "||") {
+		name := strings.TrimPrefix(part, "strict_")
+		if _, found := elasticsearchEpochs[part]; found {
+			alternatives = append(alternatives, part)
+			continue
+		}
+
+		pattern, named := elasticsearchFormats[name]
+		if !named {
+			pattern = part
+		}
+		expanded, err := expandOptional(pattern, maxAlternatives-len(alternatives))
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, expanded...)
+		if !named || !fractions {
+			continue
+		}
+		// Elasticsearch reads up to nine fractional digits where the named formats write milliseconds.
+		for _, pattern := range expanded {
+			if strings.Contains(pattern, ".SSS") && !strings.Contains(pattern, ".SSSS") {
+				alternatives = append(alternatives, strings.Replace(pattern, ".SSS", ".SSSSSSSSS", 1))
+			}
+		}
+	}
+	if len(alternatives) > maxAlternatives {
+		return nil, errors.New("strftime: too many alternatives in Elasticsearch date format " + format)
+	}
+
+	return alternatives, nil
+}
+
+// expandOptional returns the patterns a pattern with optional sections in square brackets stands for, longest
+// first. Brackets in quoted text are literal. It returns an error if there are more than max patterns.
+func expandOptional(pattern string, max int) ([]string, error) {
+	start, end := optionalSection(pattern)
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+
+	inner, err := expandOptional(pattern[start+1:end], max)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := expandOptional(pattern[end+1:], max)
+	if err != nil {
+		return nil, err
+	}
+	if (len(inner)+1)*len(rest) > max {
+		return nil, errors.New("strftime: too many optional sections in " + pattern)
+	}
+
+	var patterns []string
+	for _, section := range append(inner, "") {
+		for _, r := range rest {
+			patterns = append(patterns, pattern[:start]+section+r)
+		}
+	}
+
+	return patterns, nil
+}
+
+// optionalSection returns the offsets of the brackets of the first optional section of a pattern, -1 if there is
+// none. An unterminated section is left to the pattern.
+func optionalSection(pattern string) (start, end int) {
+	start, depth, quoted := -1, 0, false
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case pattern[i] == '\'':
+			quoted = !quoted
+		case quoted:
+		case pattern[i] == '[':
+			if depth == 0 {
+				start = i
+			}
+			depth++
+		case pattern[i] == ']' && depth > 0:
+			depth--
+			if depth == 0 {
+				return start, i
+			}
+		}
+	}
+
+	return -1, -1
+}
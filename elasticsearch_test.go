@@ -0,0 +1,136 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompileElasticsearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Date optional time with year", format: "strict_date_optional_time", value: "2021", want: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Date optional time with date", format: "strict_date_optional_time", value: "2021-02-03", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Date optional time with hour", format: "strict_date_optional_time", value: "2021-02-03T04", want: time.Date(2021, time.February, 3, 4, 0, 0, 0, time.UTC)},
+		{name: "Date optional time with UTC", format: "strict_date_optional_time", value: "2021-02-03T04:05:06.789Z", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+		{name: "Date optional time with nanoseconds", format: "date_optional_time", value: "2021-02-03T04:05:06.123456789+01:00", want: time.Date(2021, time.February, 3, 3, 5, 6, 123456789, time.UTC)},
+		{name: "Date optional time with trailing text", format: "strict_date_optional_time", value: "2021-02-03T04:05:06 UTC", wantErr: true},
+		{name: "Epoch milliseconds", format: "epoch_millis", value: "1612325106789", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+		{name: "Negative epoch milliseconds", format: "epoch_millis", value: "-1500", want: time.Date(1969, time.December, 31, 23, 59, 58, 500000000, time.UTC)},
+		{name: "Epoch seconds", format: "epoch_second", value: "1612325106", want: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)},
+		{name: "Basic date time", format: "basic_date_time", value: "20210203T040506.789+0100", want: time.Date(2021, time.February, 3, 3, 5, 6, 789000000, time.UTC)},
+		{name: "Week date", format: "strict_week_date", value: "2021-W05-3", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Ordinal date", format: "ordinal_date", value: "2021-034", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Alternatives", format: "yyyy-MM-dd HH:mm:ss||yyyy-MM-dd||epoch_millis", value: "1612325106789", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+		{name: "Second alternative", format: "yyyy-MM-dd HH:mm:ss||yyyy-MM-dd||epoch_millis", value: "2021-02-03", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Optional section in pattern", format: "dd/MMM/yyyy[ HH:mm]", value: "03/Feb/2021 04:05", want: time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC)},
+		{name: "No alternative matches", format: "yyyy-MM-dd||epoch_millis", value: "03/02/2021", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps, err := CompileElasticsearch(tt.format)
+			if err != nil {
+				t.Fatalf("CompileElasticsearch() error = %v", err)
+			}
+			got, err := ps.Parse(tt.value)
+			if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileElasticsearch_errors(t *testing.T) {
+	if _, err := CompileElasticsearch("yyyy-MM-dd G"); err == nil {
+		t.Errorf("CompileElasticsearch() with an unknown pattern letter error = nil")
+	}
+	if _, err := CompileElasticsearch("yyyy[-MM][-dd][HH][mm][ss][SSS][XXX][a][b][c]"); err == nil {
+		t.Errorf("CompileElasticsearch() with too many optional sections error = nil")
+	}
+}
+
+func TestFormat_elasticsearch(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)
+	tests := []struct {
+		format string
+		t      time.Time
+		want   string
+	}{
+		{format: "strict_date_optional_time", t: tm, want: "2021-02-03T04:05:06.789Z"},
+		{format: "date_time_no_millis", t: tm.In(time.FixedZone("", -5*60*60)), want: "2021-02-02T23:05:06-05:00"},
+		{format: "basic_week_date", t: tm, want: "2021W053"},
+		{format: "epoch_millis||date", t: tm, want: "1612325106789"},
+		{format: "dd MMM yyyy", t: tm, want: "03 Feb 2021"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectElasticsearch)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			ps, err := CompileElasticsearch(tt.format)
+			if got := ps.Format(tt.t); err != nil || got != tt.want {
+				t.Errorf("Patterns.Format() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_elasticsearch(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "Named format to strftime", format: "strict_date", from: DialectElasticsearch, to: DialectC, want: "%Y-%m-%d"},
+		{name: "Pattern to strftime", format: "yyyy-MM-dd'T'HH:mm:ss.SSSZ", from: DialectElasticsearch, to: DialectC, want: "%Y-%m-%dT%H:%M:%S.%3N%z"},
+		{name: "Alternatives", format: "yyyy-MM-dd||epoch_millis", from: DialectElasticsearch, to: DialectC, wantSpecs: []string{"yyyy-MM-dd||epoch_millis"}},
+		{name: "Epoch", format: "epoch_millis", from: DialectElasticsearch, to: DialectC, wantSpecs: []string{"<milliseconds since the Epoch>"}},
+		{name: "Named format to ICU", format: "date_time", from: DialectElasticsearch, to: DialectICU, want: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"},
+		{name: "strftime to pattern", format: "%d %b %Y %H:%M", from: DialectC, to: DialectElasticsearch, want: "dd MMM yyyy HH:mm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func Test_expandOptional(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{pattern: "yyyy", want: []string{"yyyy"}},
+		{pattern: "yyyy[-MM[-dd]]", want: []string{"yyyy-MM-dd", "yyyy-MM", "yyyy"}},
+		{pattern: "HH[:mm][:ss]", want: []string{"HH:mm:ss", "HH:mm", "HH:ss", "HH"}},
+		{pattern: "'['yyyy']'[X]", want: []string{"'['yyyy']'X", "'['yyyy']'"}},
+		{pattern: "yyyy[-MM", want: []string{"yyyy[-MM"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := expandOptional(tt.pattern, maxAlternatives)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandOptional() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
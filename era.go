@@ -0,0 +1,122 @@
+package strftime
+
+import (
+	"sync"
+	"time"
+)
+
+// Era describes a period of an era based calendar, such as a Japanese imperial era. Eras follow the era definitions
+// of the POSIX LC_TIME locale category: within an era, years are numbered from the era's offset onwards, starting
+// with the year containing its first day.
+type Era struct {
+	// Name is the name of the era, substituted for %EC.
+	Name string
+	// Start is the first day of the era and End its last day. A zero End makes the era open ended. For an era
+	// counting backwards, Start is the latest day of the era and End, if set, lies before it.
+	Start time.Time
+	End   time.Time
+	// Offset is the number of the era year containing Start, usually 1.
+	Offset int
+	// Backward reports whether the era years increase towards the past, as for years before an epoch.
+	Backward bool
+	// Format is the strftime format substituted for %EY, for example "%EC%Ey年". It may refer to %EC and %Ey, but
+	// not to %Ec, %Ex and %EX, which are read as literal text.
+	Format string
+	// FirstYear, if set, is substituted for %Ey instead of the number of the first year of the era, as in 元年.
+	FirstYear string
+}
+
+// contains reports whether the day of t falls within the era.
+func (e *Era) contains(t time.Time) bool {
+	day := dateKey(t)
+	if e.Backward {
+		return day <= dateKey(e.Start) && (e.End.IsZero() || day >= dateKey(e.End))
+	}
+
+	return day >= dateKey(e.Start) && (e.End.IsZero() || day <= dateKey(e.End))
+}
+
+// year returns the era year of t.
+func (e *Era) year(t time.Time) int {
+	if e.Backward {
+		return e.Start.Year() - t.Year() + e.Offset
+	}
+
+	return t.Year() - e.Start.Year() + e.Offset
+}
+
+// containsYear reports whether the Gregorian year overlaps the era.
+func (e *Era) containsYear(year int) bool {
+	if e.Backward {
+		return year <= e.Start.Year() && (e.End.IsZero() || year >= e.End.Year())
+	}
+
+	return year >= e.Start.Year() && (e.End.IsZero() || year <= e.End.Year())
+}
+
+// gregorianYear returns the Gregorian year of the era year.
+func (e *Era) gregorianYear(year int) int {
+	if e.Backward {
+		return e.Start.Year() - (year - e.Offset)
+	}
+
+	return e.Start.Year() + (year - e.Offset)
+}
+
+// eraSyntax is the syntax of era formats. It rejects the era based representations, which may refer to the era
+// format themselves.
+var eraSyntax = &syntax{
+	specs:     packageSyntax.specs,
+	flags:     packageSyntax.flags,
+	width:     true,
+	modifiers: true,
+	colons:    true,
+	eraSpecs:  "CyY",
+}
+
+// eraItems caches the compiled era formats by format and locale.
+var eraItems sync.Map // map[eraKey][]item
+
+type eraKey struct {
+	format string
+	l      *Locale
+}
+
+// items returns the compiled era format. A reference to %EY within it is treated as %Y.
+func (e *Era) items(l *Locale) []item {
+	key := eraKey{format: e.Format, l: l}
+	if items, found := eraItems.Load(key); found {
+		return items.([]item)
+	}
+
+	items, _ := eraSyntax.appendItems(nil, e.Format, l, 0)
+	for i := range items {
+		if items[i].spec == 'Y' && items[i].mod == 'E' {
+			items[i].mod = 0
+		}
+	}
+	eraItems.Store(key, items)
+
+	return items
+}
+
+// era returns the first of the locale's eras the day of t falls within, or nil.
+func (l *Locale) era(t time.Time) *Era {
+	for i := range l.Eras {
+		if l.Eras[i].contains(t) {
+			return &l.Eras[i]
+		}
+	}
+
+	return nil
+}
+
+// dateKey returns an integer that orders days chronologically regardless of time of day and location.
+func dateKey(t time.Time) int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
+
+// date returns midnight UTC of the given day, for use in era definitions.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
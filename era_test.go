@@ -0,0 +1,77 @@
+package strftime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEra_year(t *testing.T) {
+	type args struct {
+		t time.Time
+	}
+	tests := []struct {
+		name     string
+		era      *Era
+		args     args
+		want     int
+		contains bool
+	}{
+		{
+			name:     "First day of Reiwa",
+			era:      &JaJP.Eras[0],
+			args:     args{t: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+			want:     1,
+			contains: true,
+		},
+		{
+			name:     "Last day of Heisei",
+			era:      &JaJP.Eras[1],
+			args:     args{t: time.Date(2019, time.April, 30, 23, 59, 59, 0, time.UTC)},
+			want:     31,
+			contains: true,
+		},
+		{
+			name:     "Day after the end of Showa",
+			era:      &JaJP.Eras[2],
+			args:     args{t: time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC)},
+			want:     64,
+			contains: false,
+		},
+		{
+			name: "Backward era",
+			era: &Era{
+				Name: "BC", Start: time.Date(0, time.December, 31, 0, 0, 0, 0, time.UTC), Offset: 1, Backward: true,
+			},
+			args:     args{t: time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)},
+			want:     44,
+			contains: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.era.year(tt.args.t); got != tt.want {
+				t.Errorf("year() = %v, want %v", got, tt.want)
+			}
+			if got := tt.era.contains(tt.args.t); got != tt.contains {
+				t.Errorf("contains() = %v, want %v", got, tt.contains)
+			}
+			if got := tt.era.gregorianYear(tt.want); got != tt.args.t.Year() {
+				t.Errorf("gregorianYear() = %v, want %v", got, tt.args.t.Year())
+			}
+		})
+	}
+}
+
+func TestEra_items(t *testing.T) {
+	l := &Locale{
+		Eras:        []Era{{Name: "E", Start: date(2000, time.January, 1), Offset: 1, Format: "%EC%Ey %Ec %EY"}},
+		EraDateTime: "%EY",
+	}
+	got := Format("%EY", time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC), WithLocale(l))
+	if want := "E20 %Ec 2019"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+	if items, again := l.Eras[0].items(l), l.Eras[0].items(l); &items[0] != &again[0] {
+		t.Errorf("items() compiled the era format again")
+	}
+}
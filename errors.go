@@ -0,0 +1,59 @@
+package strftime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ConversionError reports the parts of a format that have no equivalent in the format language it is converted to.
+type ConversionError struct {
+	// Format is the format being converted and Target the name of the format language it is converted to.
+	Format string
+	Target string
+	// Specs holds the conversion specifications or formatting elements without an equivalent.
+	Specs []string
+	// Collisions holds literal text the target would read as formatting elements.
+	Collisions []string
+}
+
+func (e *ConversionError) Error() string {
+	var b strings.Builder
+	b.WriteString("strftime: can not convert " + strconv.Quote(e.Format) + " to " + e.Target)
+	if len(e.Specs) > 0 {
+		b.WriteString(": no equivalent for " + strings.Join(e.Specs, ", "))
+	}
+	if len(e.Collisions) > 0 {
+		if len(e.Specs) > 0 {
+			b.WriteString(";")
+		} else {
+			b.WriteString(":")
+		}
+		b.WriteString(" literal text read as formatting elements:")
+		for i, c := range e.Collisions {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(" " + strconv.Quote(c))
+		}
+	}
+
+	return b.String()
+}
+
+// empty reports whether no problems were recorded.
+func (e *ConversionError) empty() bool {
+	return len(e.Specs) == 0 && len(e.Collisions) == 0
+}
+
+// FormatError reports an unknown conversion specification in a format string.
+type FormatError struct {
+	// Format is the format string and Offset the byte offset of the conversion specification Elem in it.
+	Format string
+	Offset int
+	Elem   string
+}
+
+func (e *FormatError) Error() string {
+	return "strftime: unknown conversion specification " + strconv.Quote(e.Elem) + " at offset " +
+		strconv.Itoa(e.Offset) + " of " + strconv.Quote(e.Format)
+}
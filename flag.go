@@ -0,0 +1,66 @@
+package strftime
+
+import (
+	"flag"
+	"time"
+)
+
+// A TimeValue is a flag.Value holding a time, parsed with the first of one or more patterns that matches and
+// printed with the first. It also has the Type method of the Value interface of the spf13/pflag package.
+type TimeValue struct {
+	t        *time.Time
+	patterns Patterns
+}
+
+// NewTimeValue returns a TimeValue setting *p, which also holds the default value, from values in any of the
+// formats. It panics if a format can not be compiled, as MustCompile does.
+func NewTimeValue(p *time.Time, formats ...string) *TimeValue {
+	v := &TimeValue{t: p}
+	for _, format := range formats {
+		v.patterns = append(v.patterns, MustCompile(format))
+	}
+
+	return v
+}
+
+// TimeVar defines a time flag with the given name and usage in fs, or flag.CommandLine if fs is nil. The flag value
+// is parsed with format and stored in *p, whose value is the default, as in
+//
+//	var since time.Time
+//	strftime.TimeVar(nil, &since, "since", "%Y-%m-%d", "show entries since the `date`")
+func TimeVar(fs *flag.FlagSet, p *time.Time, name, format, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	fs.Var(NewTimeValue(p, format), name, usage)
+}
+
+// String returns the time formatted with the first pattern, or the empty string for the zero time.
+func (v *TimeValue) String() string {
+	if v == nil || v.t == nil || v.t.IsZero() {
+		return ""
+	}
+
+	return v.patterns.Format(*v.t)
+}
+
+// Set parses the flag value with the first pattern that matches it.
+func (v *TimeValue) Set(s string) error {
+	t, err := v.patterns.Parse(s)
+	if err != nil {
+		return err
+	}
+	*v.t = t
+
+	return nil
+}
+
+// Get returns the time, implementing the flag.Getter interface.
+func (v *TimeValue) Get() any {
+	return *v.t
+}
+
+// Type returns the name of the flag type shown in pflag usage messages.
+func (v *TimeValue) Type() string {
+	return "time"
+}
@@ -0,0 +1,72 @@
+package strftime
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeVar(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Value", args: []string{"-since", "2019-05-11"}, want: time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC)},
+		{name: "Default", want: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Mismatched input", args: []string{"-since", "05/11/2019"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			since := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+			TimeVar(fs, &since, "since", "%Y-%m-%d", "show entries since the `date`")
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr || !tt.wantErr && !since.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", since, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeVar_usage(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var b strings.Builder
+	fs.SetOutput(&b)
+	since := time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC)
+	var at time.Time
+	TimeVar(fs, &since, "since", "%Y-%m-%d", "show entries since the `date`")
+	TimeVar(fs, &at, "at", "%m/%d/%Y %H:%M", "run at the given time")
+	fs.PrintDefaults()
+
+	want := "  -at value\n    \trun at the given time\n  -since date\n    \tshow entries since the date (default 2019-05-11)\n"
+	if b.String() != want {
+		t.Errorf("PrintDefaults() wrote %q, want %q", b.String(), want)
+	}
+}
+
+func TestTimeValue(t *testing.T) {
+	var at time.Time
+	v := NewTimeValue(&at, "%m/%d/%Y %H:%M", "%Y-%m-%dT%H:%M")
+	for _, s := range []string{"05/11/2019 23:45", "2019-05-11T23:45"} {
+		if err := v.Set(s); err != nil || !at.Equal(time.Date(2019, time.May, 11, 23, 45, 0, 0, time.UTC)) {
+			t.Errorf("Set(%q) = %v, %v", s, at, err)
+		}
+	}
+	if got := v.String(); got != "05/11/2019 23:45" {
+		t.Errorf("String() = %q, want %q", got, "05/11/2019 23:45")
+	}
+	if got, ok := v.Get().(time.Time); !ok || !got.Equal(at) {
+		t.Errorf("Get() = %v, want %v", v.Get(), at)
+	}
+	if v.Type() != "time" {
+		t.Errorf("Type() = %q, want %q", v.Type(), "time")
+	}
+	if err := v.Set("2019-05-11"); err == nil {
+		t.Errorf("Set() with mismatched input error = nil")
+	}
+}
@@ -1,11 +1,211 @@
 package strftime
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
-// Format returns the provided time.Time formatted according to the strftime(3) based format string
-func Format(format string, t time.Time) string {
-	layout := parseFormat(format, convSpecs)
-	timeString := t.Format(layout)
+// Format returns the provided time.Time formatted according to the strftime(3) based format string, or a format
+// string of the dialect selected with WithDialect. An unknown locale name selected with WithLocaleName is ignored.
+func Format(format string, t time.Time, opts ...Option) string {
+	o := newOptions(opts)
+	items, _ := o.dialect.compile(format, o.locale)
+	if o.location != nil {
+		t = t.In(o.location)
+	}
 
-	return secondaryFormat(t, timeString)
+	return string(appendFormat(make([]byte, 0, len(format)*2), items, t, o.locale))
+}
+
+func appendFormat(b []byte, items []item, t time.Time, l *Locale) []byte {
+	for _, it := range items {
+		if it.spec == 0 {
+			b = append(b, it.text...)
+			continue
+		}
+		if it.spec == specUTC {
+			t = t.UTC()
+			continue
+		}
+		start := len(b)
+		b = appendSpec(b, it, t, l)
+		if it.casing != 0 {
+			b = append(b[:start], changeCase(string(b[start:]), it)...)
+		}
+	}
+
+	return b
+}
+
+// changeCase applies the case flag of a conversion specification to its output. The # flag writes names in upper case
+// and the AM/PM designation and the time zone abbreviation in lower case.
+func changeCase(s string, it item) string {
+	if it.casing == '#' && (it.spec == 'p' || it.spec == 'Z') {
+		return strings.ToLower(s)
+	}
+
+	return strings.ToUpper(s)
+}
+
+func appendSpec(b []byte, it item, t time.Time, l *Locale) []byte {
+	if it.mod == 'E' {
+		if era := l.era(t); era != nil {
+			return appendEra(b, it, t, l, era)
+		}
+	}
+
+	if n, found := numberSpecs[it.spec]; found {
+		width := n.width
+		if it.width > 0 {
+			width = it.width
+		}
+		v := numberValue(it.spec, t, l)
+		if it.pad == '+' {
+			// The + flag pads with zeros and writes a plus sign before values with more digits than the default width.
+			if limit := pow10(n.width); v >= limit {
+				b = append(b, '+')
+				width--
+			}
+			return appendNumber(b, v, width, '0')
+		}
+		return appendNumber(b, v, width, padding(it.pad, n.pad))
+	}
+
+	switch it.spec {
+	case 'a':
+		return append(b, l.ShortDays[t.Weekday()]...)
+	case 'A':
+		return append(b, l.Days[t.Weekday()]...)
+	case 'b':
+		return append(b, l.ShortMonths[t.Month()-1]...)
+	case 'B':
+		return append(b, l.Months[t.Month()-1]...)
+	case 'p':
+		return append(b, meridiem(t.Hour(), l)...)
+	case 'P':
+		return append(b, strings.ToLower(meridiem(t.Hour(), l))...)
+	case 's':
+		return strconv.AppendInt(b, t.Unix(), 10)
+	case specUnixMilli:
+		return strconv.AppendInt(b, t.UnixMilli(), 10)
+	case 'N':
+		return appendFraction(b, t.Nanosecond(), it.width)
+	case 'z':
+		switch it.colons {
+		case 1:
+			return t.AppendFormat(b, "-07:00")
+		case 2:
+			return t.AppendFormat(b, "-07:00:00")
+		}
+		return t.AppendFormat(b, "-0700")
+	case 'Z':
+		return t.AppendFormat(b, "MST")
+	case specDaySuffix:
+		return append(b, daySuffix(t.Day())...)
+	case specOffsetZ:
+		if it.colons == 1 {
+			return t.AppendFormat(b, "Z07:00")
+		}
+		return t.AppendFormat(b, "Z0700")
+	}
+
+	return b
+}
+
+// appendEra appends the era name (%EC), the era year (%Ey) or the era year in the era's own format (%EY).
+func appendEra(b []byte, it item, t time.Time, l *Locale, era *Era) []byte {
+	switch it.spec {
+	case 'C':
+		return append(b, era.Name...)
+	case 'y':
+		year := era.year(t)
+		if year == 1 && era.FirstYear != "" {
+			return append(b, era.FirstYear...)
+		}
+		return appendNumber(b, year, 1, padding(it.pad, '-'))
+	}
+
+	return appendFormat(b, era.items(l), t, l)
+}
+
+// appendNumber appends the decimal representation of v padded to at least width digits. The pad flag selects
+// padding with zeros ('0'), spaces ('_') or no padding ('-').
+func appendNumber(b []byte, v, width int, pad byte) []byte {
+	if pad == '-' {
+		width = 1
+	}
+
+	digits := 1
+	for x := v; x >= 10 || x <= -10; x /= 10 {
+		digits++
+	}
+	if pad == '_' {
+		for ; digits < width; digits++ {
+			b = append(b, ' ')
+		}
+	}
+	if v < 0 {
+		b = append(b, '-')
+		v = -v
+	}
+	for ; digits < width; digits++ {
+		b = append(b, '0')
+	}
+
+	return strconv.AppendInt(b, int64(v), 10)
+}
+
+// appendFraction appends the first digits of the fractional seconds, all nine if digits is 0.
+func appendFraction(b []byte, nsec, digits int) []byte {
+	if digits == 0 {
+		digits = 9
+	}
+	for i := digits; i < 9; i++ {
+		nsec /= 10
+	}
+
+	return appendNumber(b, nsec, digits, '0')
+}
+
+func pow10(n int) int {
+	v := 1
+	for ; n > 0; n-- {
+		v *= 10
+	}
+
+	return v
+}
+
+// padding returns the padding flag of a conversion specification, falling back to the default when none is set.
+func padding(flag, def byte) byte {
+	if flag == 0 {
+		return def
+	}
+
+	return flag
+}
+
+// daySuffix returns the English ordinal suffix of a day of the month.
+func daySuffix(day int) string {
+	switch {
+	case day/10 == 1:
+		return "th"
+	case day%10 == 1:
+		return "st"
+	case day%10 == 2:
+		return "nd"
+	case day%10 == 3:
+		return "rd"
+	}
+
+	return "th"
+}
+
+func meridiem(hour int, l *Locale) string {
+	if hour < 12 {
+		return l.AM
+	}
+
+	return l.PM
 }
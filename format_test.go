@@ -9,6 +9,7 @@ func TestFormat(t *testing.T) {
 	type args struct {
 		format string
 		t      time.Time
+		opts   []Option
 	}
 	tests := []struct {
 		name string
@@ -351,10 +352,360 @@ func TestFormat(t *testing.T) {
 			},
 			want: "18",
 		},
+		{
+			name: "Sunday and Monday weeks on a Sunday January 1st",
+			args: args{
+				format: "%U %W",
+				t:      time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "01 00",
+		},
+		{
+			name: "Sunday and Monday weeks on a Monday January 1st",
+			args: args{
+				format: "%U %W",
+				t:      time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "00 01",
+		},
+		{
+			name: "Sunday and Monday weeks on the first Sunday of the year",
+			args: args{
+				format: "%U %W",
+				t:      time.Date(2019, time.January, 6, 0, 0, 0, 0, time.UTC),
+			},
+			want: "01 00",
+		},
+		{
+			name: "February 28th 2019 %C",
+			args: args{
+				format: "%C",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "20",
+		},
+		{
+			name: "February 28th 2019 %G",
+			args: args{
+				format: "%G",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "2019",
+		},
+		{
+			name: "February 28th 2019 %g",
+			args: args{
+				format: "%g",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "19",
+		},
+		{
+			name: "February 28th 2019 %j",
+			args: args{
+				format: "%j",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "059",
+		},
+		{
+			name: "February 28th 2019 %k",
+			args: args{
+				format: "%k",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "0",
+		},
+		{
+			name: "February 28th 2019 %s",
+			args: args{
+				format: "%s",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "1551326400",
+		},
+		{
+			name: "February 28th 2019 %u",
+			args: args{
+				format: "%u",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "4",
+		},
+		{
+			name: "February 28th 2019 %U",
+			args: args{
+				format: "%U",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "08",
+		},
+		{
+			name: "February 28th 2019 %V",
+			args: args{
+				format: "%V",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "9",
+		},
+		{
+			name: "February 28th 2019 %w",
+			args: args{
+				format: "%w",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "4",
+		},
+		{
+			name: "February 28th 2019 %W",
+			args: args{
+				format: "%W",
+				t:      time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "08",
+		},
+		{
+			name: "Localized full weekday and month names",
+			args: args{
+				format: "%A %d %B %Y",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(DeDE)},
+			},
+			want: "Samstag 11 Mai 2019",
+		},
+		{
+			name: "Localized abbreviated names with non-ASCII characters",
+			args: args{
+				format: "%a %d %b",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(FrFR)},
+			},
+			want: "sam. 11 mai",
+		},
+		{
+			name: "Localized preferred date representation",
+			args: args{
+				format: "%x",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(DeDE)},
+			},
+			want: "11.05.2019",
+		},
+		{
+			name: "Localized preferred date and time representation",
+			args: args{
+				format: "%c",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(EsES)},
+			},
+			want: "sáb 11 may 2019 23:45:24 EDT",
+		},
+		{
+			name: "Literal text resembling a Go layout is kept",
+			args: args{
+				format: "Monday 2006: %Y",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(EnUS)},
+			},
+			want: "Monday 2006: 2019",
+		},
+		{
+			name: "Japanese era date",
+			args: args{
+				format: "%Ex",
+				t:      time.Date(2019, time.May, 11, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "令和元年5月11日",
+		},
+		{
+			name: "Japanese era year",
+			args: args{
+				format: "%EC %Ey %EY",
+				t:      time.Date(2019, time.April, 30, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "平成 31 平成31年",
+		},
+		{
+			name: "First day of a Japanese era",
+			args: args{
+				format: "%EY",
+				t:      time.Date(1989, time.January, 8, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "平成元年",
+		},
+		{
+			name: "Last day of a Japanese era",
+			args: args{
+				format: "%EY",
+				t:      time.Date(1989, time.January, 7, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "昭和64年",
+		},
+		{
+			name: "First year of Taisho",
+			args: args{
+				format: "%Ec",
+				t:      time.Date(1912, time.July, 30, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "大正元年7月30日 00時00分00秒",
+		},
+		{
+			name: "Before the first Japanese era",
+			args: args{
+				format: "%EC %Ey %EY",
+				t:      time.Date(1868, time.October, 22, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				opts:   []Option{WithLocale(JaJP)},
+			},
+			want: "18 68 1868",
+		},
+		{
+			name: "Padding flags",
+			args: args{
+				format: "%-d|%_m|%0e|%-H|%_j",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "11| 5|11|23|131",
+		},
+		{
+			name: "Era modifiers without eras",
+			args: args{
+				format: "%EY %Ey %EC %Od",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "2019 19 20 11",
+		},
+		{
+			name: "Thai Buddhist Era year",
+			args: args{
+				format: "%EY|%Ey|%EC",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(ThTH)},
+			},
+			want: "พ.ศ. 2562|2562|พ.ศ.",
+		},
+		{
+			name: "Thai Buddhist Era date",
+			args: args{
+				format: "%Ex",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(ThTH)},
+			},
+			want: "11 พ.ค. 2562",
+		},
+		{
+			name: "Minguo year",
+			args: args{
+				format: "%EY|%Ey|%EC",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(ZhTW)},
+			},
+			want: "民國108年|108|民國",
+		},
+		{
+			name: "Minguo date",
+			args: args{
+				format: "%Ex",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(ZhTW)},
+			},
+			want: "民國108年05月11日",
+		},
+		{
+			name: "First year of Minguo",
+			args: args{
+				format: "%EY",
+				t:      time.Date(1912, time.January, 1, 0, 0, 0, 0, time.UTC),
+				opts:   []Option{WithLocale(ZhTW)},
+			},
+			want: "民國元年",
+		},
+		{
+			name: "Year before Minguo",
+			args: args{
+				format: "%EY",
+				t:      time.Date(1911, time.December, 31, 0, 0, 0, 0, time.UTC),
+				opts:   []Option{WithLocale(ZhTW)},
+			},
+			want: "民前1年",
+		},
+		{
+			name: "Years counted backwards before Minguo",
+			args: args{
+				format: "%EY",
+				t:      time.Date(1900, time.June, 1, 0, 0, 0, 0, time.UTC),
+				opts:   []Option{WithLocale(ZhTW)},
+			},
+			want: "民前12年",
+		},
+		{
+			name: "Locale week of year and weekday number, week starting on Sunday",
+			args: args{
+				format: "%K %o",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(EnUS)},
+			},
+			want: "19 7",
+		},
+		{
+			name: "Locale week of year and weekday number, week starting on Monday",
+			args: args{
+				format: "%K %o",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				opts:   []Option{WithLocale(DeDE)},
+			},
+			want: "19 6",
+		},
+		{
+			name: "Locale week based year at the start of the year",
+			args: args{
+				format: "%J-W%K-%o",
+				t:      time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+				opts:   []Option{WithLocale(DeDE)},
+			},
+			want: "2021-W52-6",
+		},
+		{
+			name: "Locale week based year at the end of the year",
+			args: args{
+				format: "%J-W%K-%o",
+				t:      time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC),
+				opts:   []Option{WithLocale(EnUS)},
+			},
+			want: "2020-W01-3",
+		},
+		{
+			name: "Fractional seconds",
+			args: args{
+				format: "%N|%3N|%6N",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 123456789, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "123456789|123|123456",
+		},
+		{
+			name: "Colon separated numeric time zones",
+			args: args{
+				format: "%:z|%::z",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 123456789, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "-04:00|-04:00:00",
+		},
+		{
+			name: "Field width",
+			args: args{
+				format: "%4d|%_4m|%10s",
+				t:      time.Date(2019, time.May, 11, 23, 45, 24, 123456789, time.FixedZone("EDT", -4*60*60)),
+			},
+			want: "0011|   5|1557632724",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Format(tt.args.format, tt.args.t); got != tt.want {
+			if got := Format(tt.args.format, tt.args.t, tt.args.opts...); got != tt.want {
 				t.Errorf("Format() = %v, want %v", got, tt.want)
 			}
 		})
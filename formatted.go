@@ -0,0 +1,123 @@
+package strftime
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// A FormatSpec supplies the format string of a Formatted time. Its Format method is called on the zero value and
+// must always return the same format, as in
+//
+//	type ISODate struct{}
+//
+//	func (ISODate) Format() string { return "%F" }
+type FormatSpec interface {
+	Format() string
+}
+
+// Formatted is a time.Time encoded as text in the format of F, as in Formatted[ISODate]. It implements the
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces used by encoding/xml and many configuration and CSV
+// libraries, and the json.Marshaler and json.Unmarshaler interfaces. The format is compiled once and cached.
+type Formatted[F FormatSpec] struct {
+	time.Time
+}
+
+func (t Formatted[F]) pattern() (*Pattern, error) {
+	var f F
+	return cachedPattern(f.Format())
+}
+
+// String returns the time formatted in the format of F, or the compile error of the format.
+func (t Formatted[F]) String() string {
+	p, err := t.pattern()
+	if err != nil {
+		return err.Error()
+	}
+
+	return p.Format(t.Time)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Formatted[F]) MarshalText() ([]byte, error) {
+	p, err := t.pattern()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.AppendFormat(nil, t.Time), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The text must match the format of F.
+func (t *Formatted[F]) UnmarshalText(text []byte) error {
+	p, err := t.pattern()
+	if err != nil {
+		return err
+	}
+	parsed, err := p.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, which takes precedence over the encoding.TextMarshaler
+// interface promoted from time.Time. The time is a quoted string in the format of F.
+func (t Formatted[F]) MarshalJSON() ([]byte, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The time must be a quoted string in the format of F. The
+// JSON null value leaves the time unchanged.
+func (t *Formatted[F]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.New("strftime: Formatted.UnmarshalJSON: input is not a JSON string")
+	}
+
+	return t.UnmarshalText([]byte(s))
+}
+
+// patterns caches the patterns of up to maxCachedPatterns format strings compiled with the default options.
+var patterns struct {
+	sync.RWMutex
+	m map[string]*Pattern
+}
+
+const maxCachedPatterns = 1024
+
+// cachedPattern returns the pattern of a format string compiled with the default options, compiling it on first use.
+func cachedPattern(format string) (*Pattern, error) {
+	patterns.RLock()
+	p, found := patterns.m[format]
+	patterns.RUnlock()
+	if found {
+		return p, nil
+	}
+
+	p, err := Compile(format)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Lock()
+	if patterns.m == nil {
+		patterns.m = make(map[string]*Pattern)
+	}
+	if len(patterns.m) < maxCachedPatterns {
+		patterns.m[format] = p
+	}
+	patterns.Unlock()
+
+	return p, nil
+}
@@ -0,0 +1,72 @@
+package strftime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type isoDate struct{}
+
+func (isoDate) Format() string { return "%F" }
+
+type dayMonthYear struct{}
+
+func (dayMonthYear) Format() string { return "%d/%m/%Y %H:%M" }
+
+type invalidFormat struct{}
+
+func (invalidFormat) Format() string { return "%Y %Q" }
+
+func TestFormatted_MarshalText(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	if got, err := (Formatted[isoDate]{tm}).MarshalText(); err != nil || string(got) != "2021-02-03" {
+		t.Errorf("MarshalText() = %q, %v, want %q", got, err, "2021-02-03")
+	}
+	if got, err := (Formatted[dayMonthYear]{tm}).MarshalText(); err != nil || string(got) != "03/02/2021 04:05" {
+		t.Errorf("MarshalText() = %q, %v, want %q", got, err, "03/02/2021 04:05")
+	}
+	var ferr *FormatError
+	if _, err := (Formatted[invalidFormat]{tm}).MarshalText(); !errors.As(err, &ferr) {
+		t.Errorf("MarshalText() error = %v, want a *FormatError", err)
+	}
+	if got := (Formatted[isoDate]{tm}).String(); got != "2021-02-03" {
+		t.Errorf("String() = %q, want %q", got, "2021-02-03")
+	}
+}
+
+func TestFormatted_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Date", text: "2021-02-03", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Mismatched input", text: "03/02/2021", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Formatted[isoDate]
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+				t.Errorf("UnmarshalText() = %v, %v, want %v", got.Time, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatted_json(t *testing.T) {
+	type record struct {
+		Day Formatted[isoDate] `json:"day"`
+	}
+	var r record
+	if err := json.Unmarshal([]byte(`{"day":"2021-02-03"}`), &r); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	got, err := json.Marshal(r)
+	if want := `{"day":"2021-02-03"}`; err != nil || string(got) != want {
+		t.Errorf("json.Marshal() = %s, %v, want %s", got, err, want)
+	}
+}
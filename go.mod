@@ -1,3 +1,3 @@
 module github.com/csotherden/strftime
 
-go 1.18
+go 1.21
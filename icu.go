@@ -0,0 +1,244 @@
+package strftime
+
+import "strings"
+
+// DialectICU is the date pattern language of ICU and Java DateTimeFormatter, as in yyyy-MM-dd'T'HH:mm:ss.SSSXXX.
+// Text in single quotes is literal and two single quotes stand for one. Fractional seconds (S) compile to %N with the
+// number of digits as width. XX and XXXX write Z for UTC and the offset as in %z otherwise; XXX, XXXXX and ZZZZZ
+// write Z for UTC and the offset as in %:z otherwise. Eras (G), quarters (Q), optional sections and other pattern
+// letters without a strftime equivalent are reported as errors.
+var DialectICU = &Dialect{
+	name:   "ICU",
+	target: "an ICU pattern",
+	compile: func(pattern string, _ *Locale) ([]item, error) {
+		return compileICU(pattern)
+	},
+	render: renderICU,
+}
+
+// icuSpecs holds the strftime conversion specifications equivalent to ICU and Java DateTimeFormatter pattern
+// letters, keyed by the letter repeated as often as it appears in the pattern. Week based fields follow ISO 8601:
+// Y is the week based year of %G, w the week of %V and e the weekday number of %u, with Monday as 1.
+var icuSpecs = map[string]string{
+	"y": "%Y", "yy": "%y", "yyy": "%Y", "yyyy": "%Y", "u": "%Y", "uu": "%y", "uuu": "%Y", "uuuu": "%Y",
+	"Y": "%G", "YY": "%g", "YYY": "%G", "YYYY": "%G",
+	"M": "%-m", "MM": "%m", "MMM": "%b", "MMMM": "%B", "L": "%-m", "LL": "%m", "LLL": "%b", "LLLL": "%B",
+	"d": "%-d", "dd": "%d", "D": "%-j", "DDD": "%j",
+	"w": "%-V", "ww": "%0V", "e": "%u", "c": "%u",
+	"E": "%a", "EE": "%a", "EEE": "%a", "EEEE": "%A", "eee": "%a", "eeee": "%A", "ccc": "%a", "cccc": "%A",
+	"a": "%p",
+	"H": "%-H", "HH": "%H", "h": "%-I", "hh": "%I",
+	"m": "%-M", "mm": "%M", "s": "%-S", "ss": "%S",
+	"z": "%Z", "zz": "%Z", "zzz": "%Z",
+	"Z": "%z", "ZZ": "%z", "ZZZ": "%z",
+	"xx": "%z", "xxx": "%:z",
+}
+
+// icuLetters holds the ICU pattern letters equivalent to conversion specifications, keyed by the specification in
+// strftime syntax.
+var icuLetters = map[string]string{
+	"%a": "EEE", "%A": "EEEE", "%b": "MMM", "%B": "MMMM",
+	"%d": "dd", "%-d": "d", "%e": "d", "%-e": "d", "%0e": "dd",
+	"%G": "YYYY", "%g": "YY",
+	"%H": "HH", "%-H": "H", "%k": "H", "%-k": "H", "%0k": "HH",
+	"%I": "hh", "%-I": "h", "%l": "h", "%-l": "h", "%0l": "hh",
+	"%j": "DDD", "%-j": "D", "%m": "MM", "%-m": "M", "%M": "mm", "%-M": "m",
+	"%p": "a", "%S": "ss", "%-S": "s", "%u": "e", "%V": "w", "%-V": "w", "%0V": "ww",
+	"%y": "yy", "%Y": "yyyy", "%z": "xx", "%:z": "xxx", "%Z": "zzz",
+}
+
+// FromICU converts an ICU or Java DateTimeFormatter date pattern, such as yyyy-MM-dd'T'HH:mm:ss.SSSXXX, to a
+// strftime format. Text in single quotes is literal and two single quotes stand for one. Fractional seconds (S) become
+// %N with the number of digits as width. The offsets writing Z for UTC (XX and XXX) become %z and %:z, which write
+// +0000 and +00:00 for UTC instead. It returns a *ConversionError listing the pattern letters without a strftime
+// equivalent, for example eras (G), quarters (Q) and optional sections.
+func FromICU(pattern string) (string, error) {
+	return Convert(pattern, DialectICU, DialectC)
+}
+
+// ToICU converts a strftime format to an ICU or Java DateTimeFormatter date pattern. Literal text containing letters
+// is quoted. It returns a *ConversionError listing the conversion specifications without a pattern equivalent, such
+// as %s and %U.
+func ToICU(format string) (string, error) {
+	return Convert(format, DialectC, DialectICU)
+}
+
+func renderICU(items []item, cerr *ConversionError) string {
+	var b strings.Builder
+	for _, it := range items {
+		switch {
+		case it.spec == 0:
+			b.WriteString(quoteICU(it.text))
+		case it.spec == 'N' && it.width == 0:
+			b.WriteString(strings.Repeat("S", 9))
+		case it.spec == 'N':
+			b.WriteString(strings.Repeat("S", it.width))
+		case it.spec == specOffsetZ && it.colons == 1:
+			b.WriteString("XXX")
+		case it.spec == specOffsetZ:
+			b.WriteString("XX")
+		case icuLetters[specString(it)] != "":
+			b.WriteString(icuLetters[specString(it)])
+		default:
+			cerr.Specs = append(cerr.Specs, it.String())
+		}
+	}
+
+	return b.String()
+}
+
+// compileICU splits an ICU date pattern into literal text and conversion specifications. Pattern letters without an
+// equivalent are kept as literal text.
+func compileICU(pattern string) ([]item, error) {
+	return compileLetters(pattern, icuReserved, icuLetterItems)
+}
+
+// icuItems holds the compiled equivalents of icuSpecs and of the offsets writing Z for UTC, which have no strftime
+// equivalent.
+var icuItems = func() map[string][]item {
+	items := specTable(icuSpecs)
+	for _, letters := range []string{"XX", "XXXX"} {
+		items[letters] = []item{{spec: specOffsetZ}}
+	}
+	for _, letters := range []string{"XXX", "XXXXX", "ZZZZZ"} {
+		items[letters] = []item{{spec: specOffsetZ, colons: 1}}
+	}
+
+	return items
+}()
+
+// icuLetterItems returns the compiled equivalent of a run of ICU pattern letters.
+func icuLetterItems(letters string) ([]item, bool) {
+	if letters[0] == 'S' && len(letters) <= 9 {
+		return []item{{spec: 'N', width: len(letters)}}, true
+	}
+	spec, found := icuItems[letters]
+	return spec, found
+}
+
+// icuReserved holds the characters other than letters that ICU reserves for optional sections, padding and future use.
+const icuReserved = "[]{}#"
+
+// compileLetters splits a pattern of repeated letters, such as an ICU date pattern, into literal text and conversion
+// specifications. Text in single quotes is literal. Runs of the same letter or of the same reserved character are
+// looked up with spec, which returns their compiled equivalent. Letters without an equivalent are kept as literal text.
+func compileLetters(pattern, reserved string, spec func(letters string) ([]item, bool)) ([]item, error) {
+	cerr := &ConversionError{Format: pattern, Target: "a strftime format"}
+
+	var items []item
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			text, n := unquoteICU(pattern[i:])
+			items = appendText(items, text)
+			i += n
+		case isASCIILetter(c) || strings.IndexByte(reserved, c) >= 0:
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+			letters := pattern[i : i+n]
+			i += n
+
+			compiled, found := spec(letters)
+			if !found {
+				cerr.Specs = append(cerr.Specs, letters)
+				items = appendText(items, letters)
+				continue
+			}
+			items = append(items, compiled...)
+		default:
+			items = appendText(items, pattern[i:i+1])
+			i++
+		}
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+// unquoteICU reads the quoted text at the start of s, which starts with a single quote, and returns the literal text
+// and the number of bytes read. Two single quotes stand for one, inside or outside of quoted text. An unterminated
+// quote extends to the end of s.
+func unquoteICU(s string) (string, int) {
+	if strings.HasPrefix(s, "''") {
+		return "'", 2
+	}
+
+	var b strings.Builder
+	i := 1
+	for i < len(s) {
+		if s[i] == '\'' {
+			if i+1 < len(s) && s[i+1] == '\'' {
+				b.WriteByte('\'')
+				i += 2
+				continue
+			}
+			return b.String(), i + 1
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+
+	return b.String(), i
+}
+
+// quoteICU quotes the runs of ASCII letters and of the reserved characters in literal text. Single quotes are doubled.
+func quoteICU(text string) string {
+	quoted := func(c byte) bool {
+		return isASCIILetter(c) || c == '\'' || strings.IndexByte(icuReserved, c) >= 0
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		if !quoted(text[i]) {
+			b.WriteByte(text[i])
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(text) && quoted(text[i+n]) {
+			n++
+		}
+		run := strings.ReplaceAll(text[i:i+n], "'", "''")
+		if strings.Trim(text[i:i+n], "'") != "" {
+			run = "'" + run + "'"
+		}
+		b.WriteString(run)
+		i += n
+	}
+
+	return b.String()
+}
+
+// specString returns a conversion specification in strftime syntax, leaving out a padding flag that equals the
+// specification's default padding.
+func specString(it item) string {
+	if n, found := numberSpecs[it.spec]; found && it.pad == n.pad {
+		it.pad = 0
+	}
+
+	return it.String()
+}
+
+// formatString returns the strftime format of compiled items.
+func formatString(items []item) string {
+	var b strings.Builder
+	for _, it := range items {
+		if it.spec == 'N' && it.width == 9 {
+			it.width = 0
+		}
+		b.WriteString(it.String())
+	}
+
+	return b.String()
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
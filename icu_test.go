@@ -0,0 +1,120 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromICU(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		want      string
+		wantSpecs []string
+	}{
+		{name: "ISO 8601 with milliseconds", pattern: "yyyy-MM-dd'T'HH:mm:ss.SSSxxx", want: "%Y-%m-%dT%H:%M:%S.%3N%:z"},
+		{name: "Offsets writing Z for UTC", pattern: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX XX", want: "%Y-%m-%dT%H:%M:%S.%3N%:z %z"},
+		{name: "Names", pattern: "EEE, dd MMM yyyy HH:mm:ss zzz", want: "%a, %d %b %Y %H:%M:%S %Z"},
+		{name: "Unpadded fields", pattern: "d/M/yy h:mm a", want: "%-d/%-m/%y %-I:%M %p"},
+		{name: "Week based year", pattern: "YYYY-'W'ww-e", want: "%G-W%0V-%u"},
+		{name: "Quoted text and escaped quotes", pattern: "'o''clock' HH 'at' ''", want: "o'clock %H at '"},
+		{name: "Day of year and full names", pattern: "uuuu DDD EEEE MMMM", want: "%Y %j %A %B"},
+		{name: "Literal percent sign", pattern: "HH'%'", want: "%H%%"},
+		{name: "Letters without an equivalent", pattern: "G yyyy QQ [HH]", wantSpecs: []string{"G", "QQ", "[", "]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromICU(tt.pattern)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("FromICU() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("FromICU() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func TestToICU(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		want      string
+		wantSpecs []string
+	}{
+		{name: "ISO 8601 with milliseconds", format: "%Y-%m-%dT%H:%M:%S.%3N%:z", want: "yyyy-MM-dd'T'HH:mm:ss.SSSxxx"},
+		{name: "Composite specifications", format: "%F %T", want: "yyyy-MM-dd HH:mm:ss"},
+		{name: "Names", format: "%a, %d %b %Y %H:%M:%S %Z", want: "EEE, dd MMM yyyy HH:mm:ss zzz"},
+		{name: "Unpadded fields", format: "%-d/%-m %l:%M %p", want: "d/M h:mm a"},
+		{name: "ISO 8601 week date", format: "%G-W%V-%u", want: "YYYY-'W'w-e"},
+		{name: "Quotes and letters in literal text", format: "o'clock %H", want: "'o''clock' HH"},
+		{name: "Quotes without letters", format: "%H'%M", want: "HH''mm"},
+		{name: "Reserved characters in literal text", format: "[%Y] #%m {%d}", want: "'['yyyy']' '#'MM '{'dd'}'"},
+		{name: "Specifications without an equivalent", format: "%s %U %C", wantSpecs: []string{"%s", "%U", "%C"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToICU(tt.format)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("ToICU() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("ToICU() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func TestToICU_roundTrip(t *testing.T) {
+	for _, format := range []string{"[%Y] #%m {%d}", "o'clock %H", "%Y-%m-%dT%H:%M:%S.%3N%:z"} {
+		pattern, err := ToICU(format)
+		if err != nil {
+			t.Fatalf("ToICU(%q) error = %v", format, err)
+		}
+		if got, err := FromICU(pattern); err != nil || got != format {
+			t.Errorf("FromICU(%q) = %q, %v, want %q", pattern, got, err, format)
+		}
+	}
+}
+
+func TestFormat_icu(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		t       time.Time
+		want    string
+		// converted is the pattern converted back to ICU.
+		converted string
+	}{
+		{
+			name: "Offsets of UTC", pattern: "XXX|XXXXX|ZZZZZ|XX|XXXX|xxx|xx", t: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC),
+			want: "Z|Z|Z|Z|Z|+00:00|+0000", converted: "XXX|XXX|XXX|XX|XX|xxx|xx",
+		},
+		{
+			name: "Offsets outside UTC", pattern: "XXX|ZZZZZ|XX|xxx", t: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.FixedZone("", 3600)),
+			want: "+01:00|+01:00|+0100|+01:00", converted: "XXX|XXX|XX|xxx",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.pattern, tt.t, WithDialect(DialectICU)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			if got, err := Convert(tt.pattern, DialectICU, DialectICU); err != nil || got != tt.converted {
+				t.Errorf("Convert() = %q, %v, want %q", got, err, tt.converted)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package strftime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// A Time is a time.Time encoded in JSON as a string formatted with a pattern.
+type Time struct {
+	time.Time
+	// Pattern formats and parses the time. A nil Pattern uses the RFC 3339 format %Y-%m-%dT%H:%M:%S%:z.
+	Pattern *Pattern
+}
+
+var rfc3339Pattern = MustCompile("%Y-%m-%dT%H:%M:%S%:z")
+
+func (t Time) pattern() *Pattern {
+	if t.Pattern == nil {
+		return rfc3339Pattern
+	}
+
+	return t.Pattern
+}
+
+// MarshalJSON implements the json.Marshaler interface. The time is a quoted string formatted with the pattern.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.pattern().Format(t.Time))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The time must be a quoted string matching the pattern.
+// The JSON null value leaves the time unchanged.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.New("strftime: Time.UnmarshalJSON: input is not a JSON string")
+	}
+
+	parsed, err := t.pattern().Parse(s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+
+	return nil
+}
+
+// MarshalJSON returns the JSON encoding of v, a struct or a pointer to one, as json.Marshal does. Fields of type
+// time.Time or *time.Time with a strftime struct tag, as in `strftime:"%Y-%m-%d"`, are encoded as strings formatted
+// with the format in the tag, compiled with the options. Only the fields of v itself and of its embedded structs are
+// formatted, strftime tags in other nested structs, such as the elements of a slice, are reported as an error.
+func MarshalJSON(v any, opts ...Option) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		if rv.IsValid() && hasTaggedFields(rv.Type(), map[reflect.Type]bool{}) {
+			return nil, fmt.Errorf("strftime: %v: strftime tags in nested structs are not supported", rv.Type())
+		}
+		return data, nil
+	}
+	fields, err := taggedFields(rv.Type(), opts)
+	if err != nil || len(fields) == 0 {
+		return data, err
+	}
+
+	members, err := objectMembers(data)
+	if err != nil {
+		// v has a JSON encoding of its own.
+		return data, nil
+	}
+	for i, m := range members {
+		f, found := fields[m.key]
+		if !found {
+			continue
+		}
+		fv, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			continue
+		}
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if members[i].value, err = json.Marshal(f.pattern.Format(fv.Interface().(time.Time))); err != nil {
+			return nil, err
+		}
+	}
+
+	return encodeMembers(members), nil
+}
+
+// UnmarshalJSON parses the JSON encoded data into v, a pointer to a struct, as json.Unmarshal does. Fields of type
+// time.Time or *time.Time with a strftime struct tag, as in `strftime:"%Y-%m-%d"`, are parsed from strings with the
+// format in the tag, compiled with the options. A value that does not match the format is reported with the name of
+// the field. As with MarshalJSON, strftime tags in nested structs other than the embedded ones are reported as an
+// error.
+func UnmarshalJSON(data []byte, v any, opts ...Option) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		if rv.IsValid() && hasTaggedFields(rv.Type(), map[reflect.Type]bool{}) {
+			return fmt.Errorf("strftime: %v: strftime tags in nested structs are not supported", rv.Type())
+		}
+		return json.Unmarshal(data, v)
+	}
+	rv = rv.Elem()
+	fields, err := taggedFields(rv.Type(), opts)
+	if err != nil {
+		return err
+	}
+	members, err := objectMembers(data)
+	if len(fields) == 0 || err != nil {
+		return json.Unmarshal(data, v)
+	}
+
+	// The tagged fields are taken out of the object and parsed once the other fields are decoded.
+	var rest []member
+	var tagged []member
+	for _, m := range members {
+		if _, found := fieldFold(fields, m.key); found {
+			tagged = append(tagged, m)
+			continue
+		}
+		rest = append(rest, m)
+	}
+	if err := json.Unmarshal(encodeMembers(rest), v); err != nil {
+		return err
+	}
+
+	for _, m := range tagged {
+		f, _ := fieldFold(fields, m.key)
+		fv, err := fieldByIndexAlloc(rv, f.index)
+		if err != nil {
+			return err
+		}
+		if string(m.value) == "null" {
+			fv.Set(reflect.Zero(fv.Type()))
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(m.value, &s); err != nil {
+			return fmt.Errorf("strftime: field %s: %s is not a JSON string", f.name, m.value)
+		}
+		t, err := f.pattern.Parse(s)
+		if err != nil {
+			return fmt.Errorf("strftime: field %s: %w", f.name, err)
+		}
+		if fv.Kind() == reflect.Pointer {
+			fv.Set(reflect.New(fv.Type().Elem()))
+			fv = fv.Elem()
+		}
+		fv.Set(reflect.ValueOf(t))
+	}
+
+	return nil
+}
+
+// taggedField is a time.Time or *time.Time field with a strftime struct tag.
+type taggedField struct {
+	name    string
+	index   []int
+	pattern *Pattern
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// taggedFields returns the fields of a struct type with a strftime struct tag, keyed by their JSON object key.
+func taggedFields(t reflect.Type, opts []Option) (map[string]taggedField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	fields := make(map[string]taggedField)
+	for _, f := range reflect.VisibleFields(t) {
+		format, tagged := f.Tag.Lookup("strftime")
+		if !tagged && f.IsExported() && f.Tag.Get("json") != "-" && !flattened(f) &&
+			hasTaggedFields(f.Type, map[reflect.Type]bool{}) {
+			return nil, fmt.Errorf("strftime: field %s: strftime tags in nested structs are not supported", f.Name)
+		}
+		if !tagged || !f.IsExported() {
+			continue
+		}
+		if f.Type != timeType && f.Type != reflect.PointerTo(timeType) {
+			return nil, fmt.Errorf("strftime: field %s with a strftime tag is not a time.Time", f.Name)
+		}
+		key := f.Name
+		if tag, found := f.Tag.Lookup("json"); found {
+			if tag == "-" {
+				continue
+			}
+			if name, _, _ := strings.Cut(tag, ","); name != "" {
+				key = name
+			}
+		}
+
+		p, err := Compile(format, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("strftime: field %s: %w", f.Name, err)
+		}
+		fields[key] = taggedField{name: f.Name, index: f.Index, pattern: p}
+	}
+
+	return fields, nil
+}
+
+// flattened reports whether the fields of an embedded struct are encoded as fields of the struct embedding it.
+func flattened(f reflect.StructField) bool {
+	if !f.Anonymous {
+		return false
+	}
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return false
+	}
+	t := f.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
+// hasTaggedFields reports whether values of type t hold structs with a strftime struct tag.
+func hasTaggedFields(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return hasTaggedFields(t.Elem(), seen)
+	case reflect.Struct:
+		for _, f := range reflect.VisibleFields(t) {
+			if !f.IsExported() || f.Tag.Get("json") == "-" {
+				continue
+			}
+			if _, tagged := f.Tag.Lookup("strftime"); tagged || hasTaggedFields(f.Type, seen) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldFold returns the field of an object key, preferring an exact match to a case-insensitive one as
+// encoding/json does.
+func fieldFold(fields map[string]taggedField, key string) (taggedField, bool) {
+	if f, found := fields[key]; found {
+		return f, true
+	}
+	for k, f := range fields {
+		if strings.EqualFold(k, key) {
+			return f, true
+		}
+	}
+
+	return taggedField{}, false
+}
+
+// fieldByIndexAlloc returns the nested field of v given by index, allocating nil embedded struct pointers on the
+// way. As with encoding/json, pointers to unexported struct types can not be allocated.
+func fieldByIndexAlloc(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return v, fmt.Errorf("strftime: cannot set embedded pointer to unexported struct: %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+
+	return v, nil
+}
+
+// member is a member of a JSON object.
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// objectMembers returns the members of a JSON object in the order they appear.
+func objectMembers(data []byte) ([]member, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("strftime: JSON value is not an object")
+	}
+
+	var members []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		m := member{key: tok.(string)}
+		if err := dec.Decode(&m.value); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// encodeMembers returns the JSON object of members.
+func encodeMembers(members []member) []byte {
+	b := []byte{'{'}
+	for i, m := range members {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		key, _ := json.Marshal(m.key)
+		b = append(b, key...)
+		b = append(b, ':')
+		b = append(b, m.value...)
+	}
+
+	return append(b, '}')
+}
@@ -0,0 +1,195 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTime_MarshalJSON(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    Time
+		want string
+	}{
+		{name: "Default pattern", t: Time{Time: tm}, want: `"2021-02-03T04:05:06+00:00"`},
+		{name: "Pattern", t: Time{Time: tm, Pattern: MustCompile("%d/%m/%Y %H:%M")}, want: `"03/02/2021 04:05"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.MarshalJSON()
+			if err != nil || string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		pattern *Pattern
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Default pattern", data: `"2021-02-03T04:05:06+00:00"`, want: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)},
+		{name: "Pattern", data: `"03/02/2021 04:05"`, pattern: MustCompile("%d/%m/%Y %H:%M"), want: time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC)},
+		{name: "Null", data: `null`},
+		{name: "Mismatched input", data: `"2021-02-03"`, pattern: MustCompile("%d/%m/%Y"), wantErr: true},
+		{name: "Not a string", data: `20210203`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Time{Pattern: tt.pattern}
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, %v, want %v", got.Time, err, tt.want)
+			}
+		})
+	}
+}
+
+type jsonBase struct {
+	Updated time.Time `json:"updated" strftime:"%d/%m/%Y %H:%M"`
+}
+
+type jsonRecord struct {
+	jsonBase
+	Name     string     `json:"name"`
+	Day      time.Time  `json:"day" strftime:"%Y-%m-%d"`
+	Deadline *time.Time `json:"deadline,omitempty" strftime:"%F"`
+	Created  time.Time
+	Ignored  time.Time `json:"-" strftime:"%F"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{
+			name: "Tagged fields",
+			v:    jsonRecord{jsonBase: jsonBase{Updated: tm}, Name: "a", Day: tm, Deadline: &tm, Created: tm},
+			want: `{"updated":"03/02/2021 04:05","name":"a","day":"2021-02-03","deadline":"2021-02-03","Created":"2021-02-03T04:05:06Z"}`,
+		},
+		{
+			name: "Pointer and omitted fields",
+			v:    &jsonRecord{Name: "b", Day: tm},
+			want: `{"updated":"01/01/0001 00:00","name":"b","day":"2021-02-03","Created":"0001-01-01T00:00:00Z"}`,
+		},
+		{name: "Not a struct", v: []int{1, 2}, want: `[1,2]`},
+		{name: "Nil", v: nil, want: `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalJSON(tt.v)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	day := time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		data    string
+		want    jsonRecord
+		wantErr string
+	}{
+		{
+			name: "Tagged fields",
+			data: `{"updated":"03/02/2021 04:05","name":"a","Day":"2021-02-03","deadline":"2021-02-03","Created":"2021-02-03T00:00:00Z"}`,
+			want: jsonRecord{jsonBase: jsonBase{Updated: day.Add(4*time.Hour + 5*time.Minute)}, Name: "a", Day: day, Deadline: &day, Created: day},
+		},
+		{
+			name: "Null",
+			data: `{"name":"b","day":null,"deadline":null}`,
+			want: jsonRecord{Name: "b"},
+		},
+		{
+			name:    "Mismatched input",
+			data:    `{"name":"c","day":"03/02/2021"}`,
+			wantErr: `strftime: field Day: parsing time "03/02/2021" as "%Y-%m-%d": cannot parse "/02/2021" as "-"`,
+		},
+		{
+			name:    "Not a string",
+			data:    `{"day":20210203}`,
+			wantErr: `strftime: field Day: 20210203 is not a JSON string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got jsonRecord
+			err := UnmarshalJSON([]byte(tt.data), &got)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("UnmarshalJSON() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalJSON() = %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON_invalidTag(t *testing.T) {
+	var v struct {
+		Day  string    `strftime:"%F"`
+		Date time.Time `strftime:"%Q"`
+	}
+	if err := UnmarshalJSON([]byte(`{}`), &v); err == nil {
+		t.Errorf("UnmarshalJSON() with a tagged string field error = nil")
+	}
+
+	var w struct {
+		Date time.Time `strftime:"%Q"`
+	}
+	var ferr *FormatError
+	if err := UnmarshalJSON([]byte(`{}`), &w); !errors.As(err, &ferr) {
+		t.Errorf("UnmarshalJSON() with an invalid format error = %v, want a *FormatError", err)
+	}
+}
+
+func TestMarshalJSON_nested(t *testing.T) {
+	type inner struct {
+		Day time.Time `strftime:"%F"`
+	}
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{name: "Nested struct", v: struct{ In inner }{In: inner{Day: tm}}},
+		{name: "Slice of structs", v: struct{ Days []inner }{Days: []inner{{Day: tm}}}},
+		{name: "Map of struct pointers", v: struct{ Days map[string]*inner }{}},
+		{name: "Top level slice", v: []inner{{Day: tm}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := MarshalJSON(tt.v); err == nil {
+				t.Errorf("MarshalJSON() = %s, want an error", got)
+			}
+			ptr := reflect.New(reflect.TypeOf(tt.v))
+			if err := UnmarshalJSON([]byte(`{}`), ptr.Interface()); err == nil {
+				t.Errorf("UnmarshalJSON() error = nil")
+			}
+		})
+	}
+
+	var ignored struct {
+		In   inner `json:"-"`
+		Name string
+	}
+	if got, err := MarshalJSON(ignored); err != nil || string(got) != `{"Name":""}` {
+		t.Errorf("MarshalJSON() with an ignored nested struct = %s, %v", got, err)
+	}
+}
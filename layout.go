@@ -0,0 +1,277 @@
+package strftime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// paddedLayouts holds the Go layout elements of conversion specifications with a padding flag that differs from
+// their default padding.
+var paddedLayouts = map[item]string{
+	{spec: 'd', pad: '-'}: "2",
+	{spec: 'd', pad: '_'}: "_2",
+	{spec: 'e', pad: '_'}: "_2",
+	{spec: 'e', pad: '0'}: "02",
+	{spec: 'I', pad: '-'}: "3",
+	{spec: 'j', pad: '_'}: "__2",
+	{spec: 'l', pad: '0'}: "03",
+	{spec: 'm', pad: '-'}: "1",
+	{spec: 'M', pad: '-'}: "4",
+	{spec: 'S', pad: '-'}: "5",
+}
+
+// ToLayout converts a strftime format to a Go time package layout. It returns a *ConversionError listing the
+// conversion specifications that have no layout equivalent, such as %U and the case flags of %^a, and the literal
+// text that the time package would read as layout elements, such as "Mon" or a lone digit. Fractional seconds (%N) are
+// only representable directly after a period or comma, as in %S.%3N.
+func ToLayout(format string) (string, error) {
+	cerr := &ConversionError{Format: format, Target: "a Go layout"}
+
+	var b strings.Builder
+	var elems []string
+	items := compile(format, EnUS)
+	for i, it := range items {
+		if it.spec != 0 {
+			elem, found := layoutElem(it)
+			if it.spec == 'N' {
+				elem, found = "", false
+				if i > 0 && items[i-1].spec == 0 && strings.IndexByte(".,", lastByte(items[i-1].text)) >= 0 {
+					elem, found = fractionLayout(lastByte(items[i-1].text), it.width), true
+				}
+			}
+			if !found {
+				cerr.Specs = append(cerr.Specs, it.String())
+				continue
+			}
+			b.WriteString(elem)
+			elems = append(elems, elem)
+			continue
+		}
+
+		text := it.text
+		if i+1 < len(items) && items[i+1].spec == 'N' && strings.IndexByte(".,", lastByte(text)) >= 0 {
+			// The separator is written as part of the fractional seconds element.
+			text = text[:len(text)-1]
+		}
+		if _, elem, _ := nextLayoutElem(text); elem != "" {
+			cerr.Collisions = append(cerr.Collisions, text)
+		} else if i+1 < len(items) {
+			// A literal can also run together with the following element, as "0" does with "2" of %e.
+			if next, found := layoutElem(items[i+1]); found {
+				if _, elem, _ := nextLayoutElem(text + next); elem != next {
+					cerr.Collisions = append(cerr.Collisions, text)
+				}
+			}
+		}
+		b.WriteString(text)
+	}
+
+	layout := b.String()
+	if cerr.empty() {
+		// Adjacent elements can run together as well, as %-m followed by %-S reads as the hour element 15.
+		for i, elem := range layoutElems(layout) {
+			if i >= len(elems) || elem != elems[i] {
+				cerr.Collisions = append(cerr.Collisions, elem)
+				break
+			}
+		}
+	}
+	if !cerr.empty() {
+		return "", cerr
+	}
+
+	return layout, nil
+}
+
+// layoutElem returns the Go layout element equivalent to a conversion specification.
+func layoutElem(it item) (string, bool) {
+	switch {
+	case it.spec == 'z' && it.colons == 1:
+		return "-07:00", true
+	case it.spec == 'z' && it.colons == 2:
+		return "-07:00:00", true
+	case it.width != 0 || it.spec == 'N':
+		return "", false
+	}
+	if _, number := numberSpecs[it.spec]; it.casing != 0 && !number {
+		// The time package has no upper or swapped case names.
+		return "", false
+	}
+	if n, found := numberSpecs[it.spec]; found && it.pad != 0 && it.pad != n.pad {
+		elem, found := paddedLayouts[item{spec: it.spec, pad: it.pad}]
+		return elem, found
+	}
+	if !fieldSpecs[it.spec] {
+		return "", false
+	}
+
+	elem, found := convSpecs[it.spec]
+	return elem, found
+}
+
+// fractionLayout returns the fractional seconds layout element with the separator and number of digits.
+func fractionLayout(sep byte, digits int) string {
+	if digits == 0 {
+		digits = 9
+	}
+
+	return string(sep) + strings.Repeat("0", digits)
+}
+
+// layoutSpecs holds the strftime conversion specifications equivalent to Go layout elements. The ISO 8601 zone
+// elements, which write Z for UTC, map to the numeric zone specifications, which write +00:00 instead; when parsing,
+// the numeric zone specifications accept Z as well.
+var layoutSpecs = map[string]string{
+	"January": "%B", "Jan": "%b", "Monday": "%A", "Mon": "%a", "MST": "%Z",
+	"01": "%m", "1": "%-m", "02": "%d", "2": "%-d", "_2": "%_d", "002": "%j", "__2": "%_j",
+	"15": "%H", "03": "%I", "3": "%-I", "04": "%M", "4": "%-M", "05": "%S", "5": "%-S",
+	"2006": "%Y", "06": "%y", "PM": "%p", "pm": "%P",
+	"-0700": "%z", "-07:00": "%:z", "-07:00:00": "%::z", "Z0700": "%z", "Z07:00": "%:z", "Z07:00:00": "%::z",
+}
+
+// FromLayout converts a Go time package layout to a strftime format, splitting the layout into elements with the
+// rules of the time package. A literal % is escaped as %%. Fractional seconds such as .000 become %3N preceded by
+// the separator. It returns a *ConversionError listing the layout elements without a strftime equivalent: the
+// trimmed fractional seconds .999 and ,999, and the -07, -070000, Z07 and Z070000 zone forms.
+func FromLayout(layout string) (string, error) {
+	cerr := &ConversionError{Format: layout, Target: "a strftime format"}
+
+	var b strings.Builder
+	for layout != "" {
+		prefix, elem, suffix := nextLayoutElem(layout)
+		b.WriteString(strings.ReplaceAll(prefix, "%", "%%"))
+		layout = suffix
+
+		switch {
+		case elem == "":
+		case layoutSpecs[elem] != "":
+			b.WriteString(layoutSpecs[elem])
+		case (elem[0] == '.' || elem[0] == ',') && elem[1] == '0':
+			b.WriteString(elem[:1] + "%" + strconv.Itoa(len(elem)-1) + "N")
+		default:
+			cerr.Specs = append(cerr.Specs, elem)
+		}
+	}
+
+	if !cerr.empty() {
+		return "", cerr
+	}
+
+	return b.String(), nil
+}
+
+func lastByte(s string) byte {
+	if s == "" {
+		return 0
+	}
+
+	return s[len(s)-1]
+}
+
+// layoutElems returns the elements of a Go layout, leaving out the literal text between them.
+func layoutElems(layout string) []string {
+	var elems []string
+	for layout != "" {
+		var elem string
+		if _, elem, layout = nextLayoutElem(layout); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+
+	return elems
+}
+
+// nextLayoutElem finds the first element of a Go layout using the rules of the time package and returns the text
+// before it, the element and the text after it. If the layout contains no element, elem is empty.
+func nextLayoutElem(layout string) (prefix, elem, suffix string) {
+	split := func(i, n int) (string, string, string) {
+		return layout[:i], layout[i : i+n], layout[i+n:]
+	}
+
+	for i := 0; i < len(layout); i++ {
+		switch c := layout[i]; c {
+		case 'J': // January, Jan
+			if strings.HasPrefix(layout[i:], "Jan") {
+				if strings.HasPrefix(layout[i:], "January") {
+					return split(i, 7)
+				}
+				if !startsWithLower(layout[i+3:]) {
+					return split(i, 3)
+				}
+			}
+		case 'M': // Monday, Mon, MST
+			if strings.HasPrefix(layout[i:], "Mon") {
+				if strings.HasPrefix(layout[i:], "Monday") {
+					return split(i, 6)
+				}
+				if !startsWithLower(layout[i+3:]) {
+					return split(i, 3)
+				}
+			}
+			if strings.HasPrefix(layout[i:], "MST") {
+				return split(i, 3)
+			}
+		case '0': // 01, 02, 03, 04, 05, 06, 002
+			if i+1 < len(layout) && '1' <= layout[i+1] && layout[i+1] <= '6' {
+				return split(i, 2)
+			}
+			if strings.HasPrefix(layout[i:], "002") {
+				return split(i, 3)
+			}
+		case '1': // 15, 1
+			if strings.HasPrefix(layout[i:], "15") {
+				return split(i, 2)
+			}
+			return split(i, 1)
+		case '2': // 2006, 2
+			if strings.HasPrefix(layout[i:], "2006") {
+				return split(i, 4)
+			}
+			return split(i, 1)
+		case '_': // _2, _2006, __2
+			if strings.HasPrefix(layout[i:], "_2") {
+				// _2006 is a literal _ followed by 2006.
+				if strings.HasPrefix(layout[i:], "_2006") {
+					return split(i+1, 4)
+				}
+				return split(i, 2)
+			}
+			if strings.HasPrefix(layout[i:], "__2") {
+				return split(i, 3)
+			}
+		case '3', '4', '5':
+			return split(i, 1)
+		case 'P': // PM
+			if strings.HasPrefix(layout[i:], "PM") {
+				return split(i, 2)
+			}
+		case 'p': // pm
+			if strings.HasPrefix(layout[i:], "pm") {
+				return split(i, 2)
+			}
+		case '-', 'Z': // -070000, -07:00:00, -0700, -07:00, -07 and their Z forms
+			for _, zone := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+				if strings.HasPrefix(layout[i+1:], zone) {
+					return split(i, 1+len(zone))
+				}
+			}
+		case '.', ',': // .000, .999, ,000 and ,999 fractional seconds
+			if i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+				j := i + 1
+				for j < len(layout) && layout[j] == layout[i+1] {
+					j++
+				}
+				// The digits must end here to be fractional seconds.
+				if j == len(layout) || !isDigit(layout[j]) {
+					return split(i, j-i)
+				}
+			}
+		}
+	}
+
+	return layout, "", ""
+}
+
+func startsWithLower(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
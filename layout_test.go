@@ -0,0 +1,177 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToLayout(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		want           string
+		wantSpecs      []string
+		wantCollisions []string
+	}{
+		{
+			name:   "ANSIC",
+			format: "%a %b %_d %H:%M:%S %Y",
+			want:   time.ANSIC,
+		},
+		{
+			name:   "RFC1123Z",
+			format: "%a, %d %b %Y %T %z",
+			want:   time.RFC1123Z,
+		},
+		{
+			name:   "Composite specifications",
+			format: "%c|%D|%r",
+			want:   "Mon Jan 02 15:04:05 2006|01/02/06|03:04:05 PM",
+		},
+		{
+			name:   "Padding flags",
+			format: "%-m/%-d %_d %-I:%-M:%-S",
+			want:   "1/2 _2 3:4:5",
+		},
+		{
+			name:   "Fractional seconds and colon separated zone",
+			format: "%Y-%m-%dT%H:%M:%S.%3N%:z",
+			want:   "2006-01-02T15:04:05.000-07:00",
+		},
+		{
+			name:      "Fractional seconds without a separator",
+			format:    "%S%6N",
+			wantSpecs: []string{"%6N"},
+		},
+		{
+			name:   "Day of the year",
+			format: "%Y-%j %_j",
+			want:   "2006-002 __2",
+		},
+		{
+			name:      "Unrepresentable specifications",
+			format:    "%Y-%m week %U",
+			wantSpecs: []string{"%U"},
+		},
+		{
+			name:      "Case flags",
+			format:    "%^a %#b %^d",
+			wantSpecs: []string{"%^a", "%#b"},
+		},
+		{
+			name:           "Literals read as layout elements",
+			format:         "Monday %d of 2006",
+			wantCollisions: []string{"Monday ", " of 2006"},
+		},
+		{
+			name:           "Literal running together with the following element",
+			format:         "%m/0%e",
+			wantCollisions: []string{"/0"},
+		},
+		{
+			name:           "Adjacent elements running together",
+			format:         "%-m%-S",
+			wantCollisions: []string{"15"},
+		},
+		{
+			name:   "Literal resembling an element",
+			format: "%B Jane",
+			want:   "January Jane",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToLayout(tt.format)
+			if tt.wantSpecs == nil && tt.wantCollisions == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("ToLayout() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) {
+				t.Fatalf("ToLayout() error = %v, want a *ConversionError", err)
+			}
+			if !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) || !reflect.DeepEqual(cerr.Collisions, tt.wantCollisions) {
+				t.Errorf("ToLayout() error = %v, want specs %q and collisions %q", err, tt.wantSpecs, tt.wantCollisions)
+			}
+		})
+	}
+}
+
+func TestFromLayout(t *testing.T) {
+	tests := []struct {
+		name      string
+		layout    string
+		want      string
+		wantSpecs []string
+	}{
+		{name: "ANSIC", layout: time.ANSIC, want: "%a %b %_d %H:%M:%S %Y"},
+		{name: "RFC1123", layout: time.RFC1123, want: "%a, %d %b %Y %H:%M:%S %Z"},
+		{name: "RFC3339Nano", layout: "2006-01-02T15:04:05.000000000Z07:00", want: "%Y-%m-%dT%H:%M:%S.%9N%:z"},
+		{name: "Milliseconds", layout: "2006-01-02T15:04:05.000Z07:00", want: "%Y-%m-%dT%H:%M:%S.%3N%:z"},
+		{name: "Comma separated milliseconds", layout: "15:04:05,000", want: "%H:%M:%S,%3N"},
+		{name: "Kitchen", layout: time.Kitchen, want: "%-I:%M%p"},
+		{name: "Unpadded and space padded elements", layout: "1/2 _2 __2 002 4 5 pm", want: "%-m/%-d %_d %_j %j %-M %-S %P"},
+		{name: "Literal percent sign", layout: "15:04 100%", want: "%H:%M %-m00%%"},
+		{name: "Trimmed fractional seconds", layout: "05,999 -07", wantSpecs: []string{",999", "-07"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromLayout(tt.layout)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("FromLayout() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("FromLayout() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func TestFromLayout_format(t *testing.T) {
+	ts := time.Date(2019, time.May, 1, 9, 5, 7, 123456789, time.FixedZone("EDT", -4*60*60))
+	for _, layout := range []string{time.ANSIC, time.UnixDate, time.RFC822Z, time.RFC1123, time.RFC3339, time.Kitchen, time.StampMicro, time.DateTime} {
+		format, err := FromLayout(layout)
+		if err != nil {
+			t.Fatalf("FromLayout(%q) error = %v", layout, err)
+		}
+		if got, want := Format(format, ts), ts.Format(layout); got != want {
+			t.Errorf("Format(%q) = %q, want %q", format, got, want)
+		}
+	}
+}
+
+func Test_nextLayoutElem(t *testing.T) {
+	tests := []struct {
+		name       string
+		layout     string
+		wantPrefix string
+		wantElem   string
+		wantSuffix string
+	}{
+		{name: "Literal only", layout: "at noon", wantPrefix: "at noon"},
+		{name: "Lower case after Jan", layout: "Janet 2", wantPrefix: "Janet ", wantElem: "2"},
+		{name: "Underscore before 2006", layout: "_2006", wantPrefix: "_", wantElem: "2006"},
+		{name: "Space padded day of year", layout: "__2", wantElem: "__2"},
+		{name: "Fractional seconds", layout: "05.000Z", wantElem: "05", wantSuffix: ".000Z"},
+		{name: "Fractional seconds followed by digits", layout: ".0001", wantPrefix: ".00", wantElem: "01"},
+		{name: "ISO 8601 time zone", layout: "Z07:00 x", wantElem: "Z07:00", wantSuffix: " x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, elem, suffix := nextLayoutElem(tt.layout)
+			if prefix != tt.wantPrefix || elem != tt.wantElem || suffix != tt.wantSuffix {
+				t.Errorf("nextLayoutElem() = %q, %q, %q, want %q, %q, %q", prefix, elem, suffix, tt.wantPrefix, tt.wantElem, tt.wantSuffix)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package strftime
+
+import "time"
+
+// Locale holds the localized names and preferred date and time representations used by the conversion
+// specifications. The field values follow the conventions of the POSIX LC_TIME locale category.
+type Locale struct {
+	// Name identifies the locale, for example "en_US".
+	Name string
+	// Days and ShortDays hold the full and abbreviated weekday names, starting with Sunday (%A and %a).
+	Days      [7]string
+	ShortDays [7]string
+	// Months and ShortMonths hold the full and abbreviated month names, starting with January (%B and %b).
+	Months      [12]string
+	ShortMonths [12]string
+	// AM and PM hold the ante meridiem and post meridiem designations (%p).
+	AM string
+	PM string
+	// DateTime, Date, Time and Time12 hold the strftime formats substituted for %c, %x, %X and %r.
+	DateTime string
+	Date     string
+	Time     string
+	Time12   string
+	// Eras holds the eras of the locale's alternative calendar, most recent first. %EC, %Ey and %EY fall back to
+	// %C, %y and %Y for days outside all eras.
+	Eras []Era
+	// EraDateTime, EraDate and EraTime hold the strftime formats substituted for %Ec, %Ex and %EX. When empty the
+	// formats of %c, %x and %X are used.
+	EraDateTime string
+	EraDate     string
+	EraTime     string
+	// FirstWeekday is the first day of the week and MinDays the minimal number of days of the new year the first
+	// week of the year must contain, as used by %J, %K and %o. A zero MinDays is treated as 1.
+	FirstWeekday time.Weekday
+	MinDays      int
+}
+
+// EnUS is the American English locale. It is the default locale used by Format and Parse.
+var EnUS = &Locale{
+	Name:         "en_US",
+	Days:         [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortDays:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Months:       [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths:  [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	AM:           "AM",
+	PM:           "PM",
+	DateTime:     "%a %b %d %H:%M:%S %Y",
+	Date:         "%m/%d/%y",
+	Time:         "%H:%M:%S",
+	Time12:       "%I:%M:%S %p",
+	FirstWeekday: time.Sunday,
+	MinDays:      1,
+}
+
+// DeDE is the German locale.
+var DeDE = &Locale{
+	Name:         "de_DE",
+	Days:         [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortDays:    [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	Months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths:  [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	DateTime:     "%a %d %b %Y %T %Z",
+	Date:         "%d.%m.%Y",
+	Time:         "%T",
+	FirstWeekday: time.Monday,
+	MinDays:      4,
+}
+
+// EsES is the Spanish locale.
+var EsES = &Locale{
+	Name:         "es_ES",
+	Days:         [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	ShortDays:    [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	Months:       [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ShortMonths:  [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+	DateTime:     "%a %d %b %Y %T %Z",
+	Date:         "%d/%m/%y",
+	Time:         "%T",
+	FirstWeekday: time.Monday,
+	MinDays:      4,
+}
+
+// FrFR is the French locale.
+var FrFR = &Locale{
+	Name:         "fr_FR",
+	Days:         [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortDays:    [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Months:       [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths:  [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	DateTime:     "%a %d %b %Y %T %Z",
+	Date:         "%d/%m/%Y",
+	Time:         "%T",
+	FirstWeekday: time.Monday,
+	MinDays:      4,
+}
+
+// JaJP is the Japanese locale. Its eras are the imperial eras from Meiji onwards, with the first year of an era
+// written as 元年.
+var JaJP = &Locale{
+	Name:        "ja_JP",
+	Days:        [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	ShortDays:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	Months:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	ShortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	AM:          "午前",
+	PM:          "午後",
+	DateTime:    "%Y年%m月%d日 %H時%M分%S秒",
+	Date:        "%Y年%m月%d日",
+	Time:        "%H時%M分%S秒",
+	Time12:      "%p%I時%M分%S秒",
+	Eras: []Era{
+		{Name: "令和", Start: date(2019, time.May, 1), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+		{Name: "平成", Start: date(1989, time.January, 8), End: date(2019, time.April, 30), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+		{Name: "昭和", Start: date(1926, time.December, 25), End: date(1989, time.January, 7), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+		{Name: "大正", Start: date(1912, time.July, 30), End: date(1926, time.December, 24), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+		// Meiji began on the 8th day of the 9th month of the lunisolar calendar, 23 October 1868.
+		{Name: "明治", Start: date(1868, time.October, 23), End: date(1912, time.July, 29), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+	},
+	EraDateTime:  "%EY%-m月%-d日 %H時%M分%S秒",
+	EraDate:      "%EY%-m月%-d日",
+	FirstWeekday: time.Sunday,
+	MinDays:      1,
+}
+
+// ThTH is the Thai locale. Its era counts years of the Buddhist Era, 543 years ahead of the Gregorian calendar.
+var ThTH = &Locale{
+	Name:        "th_TH",
+	Days:        [7]string{"อาทิตย์", "จันทร์", "อังคาร", "พุธ", "พฤหัสบดี", "ศุกร์", "เสาร์"},
+	ShortDays:   [7]string{"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."},
+	Months:      [12]string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"},
+	ShortMonths: [12]string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."},
+	AM:          "AM",
+	PM:          "PM",
+	DateTime:    "วัน%Aที่ %d %B %Y, %R %Z",
+	Date:        "%d/%m/%Y",
+	Time:        "%H:%M:%S",
+	Time12:      "%I:%M:%S %p",
+	Eras: []Era{
+		// Year 1 of the Buddhist Era is 543 BC, year -542 in astronomical year numbering.
+		{Name: "พ.ศ.", Start: date(-542, time.January, 1), Offset: 1, Format: "%EC %Ey"},
+	},
+	EraDateTime:  "วัน%Aที่ %e %B %EC %Ey, %H.%M.%S น.",
+	EraDate:      "%e %b %Ey",
+	EraTime:      "%H.%M.%S น.",
+	FirstWeekday: time.Sunday,
+	MinDays:      1,
+}
+
+// ZhTW is the Traditional Chinese locale of Taiwan. Its eras count years of the Republic of China (Minguo) calendar
+// from 1912, with the years before it counted backwards.
+var ZhTW = &Locale{
+	Name:        "zh_TW",
+	Days:        [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"},
+	ShortDays:   [7]string{"日", "一", "二", "三", "四", "五", "六"},
+	Months:      [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	ShortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	AM:          "上午",
+	PM:          "下午",
+	DateTime:    "%Y年%m月%d日 (%A) %H時%M分%S秒",
+	Date:        "%Y年%m月%d日",
+	Time:        "%H時%M分%S秒",
+	Time12:      "%p %I時%M分%S秒",
+	Eras: []Era{
+		{Name: "民國", Start: date(1912, time.January, 1), Offset: 1, Format: "%EC%Ey年", FirstYear: "元"},
+		{Name: "民前", Start: date(1911, time.December, 31), Offset: 1, Backward: true, Format: "%EC%Ey年"},
+	},
+	EraDateTime:  "%EY%m月%d日 (%A) %H時%M分%S秒",
+	EraDate:      "%EY%m月%d日",
+	FirstWeekday: time.Sunday,
+	MinDays:      1,
+}
+
+func (l *Locale) minDays() int {
+	if l.MinDays < 1 {
+		return 1
+	}
+
+	return l.MinDays
+}
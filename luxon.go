@@ -0,0 +1,64 @@
+package strftime
+
+import "strings"
+
+// DialectLuxon is the format token language of the Luxon library, as in yyyy-LL-dd'T'HH:mm:ss.SSSZZ. Text in single
+// quotes is literal and characters other than letters are copied, including those that ICU reserves. x writes the
+// milliseconds since the Epoch. Tokens without a strftime equivalent, such as unpadded milliseconds (S), narrow
+// offsets (Z), zone names (z), eras (G), quarters (q) and the localized formats (D, t, f), are reported as errors.
+var DialectLuxon = &Dialect{
+	name:   "Luxon",
+	target: "a Luxon format",
+	compile: func(f string, _ *Locale) ([]item, error) {
+		return compileLetters(f, "", func(letters string) ([]item, bool) {
+			spec, found := luxonItems[letters]
+			return spec, found
+		})
+	},
+	render: renderLuxon,
+}
+
+// luxonItems holds the compiled equivalents of luxonSpecs and of the milliseconds since the Epoch, which have no
+// strftime equivalent.
+var luxonItems = func() map[string][]item {
+	items := specTable(luxonSpecs)
+	items["x"] = []item{{spec: specUnixMilli}}
+
+	return items
+}()
+
+// luxonSpecs holds the strftime conversion specifications equivalent to Luxon format tokens.
+var luxonSpecs = map[string]string{
+	"SSS": "%3N", "u": "%3N", "uu": "%2N", "uuu": "%1N",
+	"s": "%-S", "ss": "%S", "m": "%-M", "mm": "%M", "h": "%-I", "hh": "%I", "H": "%-H", "HH": "%H",
+	"ZZ": "%:z", "ZZZ": "%z", "ZZZZ": "%Z", "a": "%p",
+	"d": "%-d", "dd": "%d", "E": "%u", "EEE": "%a", "EEEE": "%A", "c": "%u", "ccc": "%a", "cccc": "%A",
+	"M": "%-m", "MM": "%m", "MMM": "%b", "MMMM": "%B", "L": "%-m", "LL": "%m", "LLL": "%b", "LLLL": "%B",
+	"y": "%-Y", "yy": "%y", "yyyy": "%Y", "kk": "%g", "kkkk": "%0G", "W": "%-V", "WW": "%0V",
+	"n": "%-K", "nn": "%K", "iiii": "%J", "o": "%-j", "ooo": "%j", "X": "%s",
+}
+
+// luxonAliases holds the tokens that are read but not written.
+var luxonAliases = map[string]bool{
+	"u": true, "c": true, "ccc": true, "cccc": true, "L": true, "LL": true, "LLL": true, "LLLL": true,
+}
+
+func renderLuxon(items []item, cerr *ConversionError) string {
+	specs := make(map[string]string, len(luxonSpecs))
+	for token, spec := range luxonSpecs {
+		if !luxonAliases[token] {
+			specs[token] = spec
+		}
+	}
+
+	table := specTable(specs)
+	table["x"] = luxonItems["x"]
+
+	return renderSpecs(items, table, func(text string) string {
+		// Luxon has no escape for a single quote.
+		if strings.Contains(text, "'") {
+			cerr.Collisions = append(cerr.Collisions, text)
+		}
+		return quoteICU(text)
+	}, cerr)
+}
@@ -0,0 +1,70 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_luxon(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "ISO 8601", format: "yyyy-LL-dd'T'HH:mm:ss.SSSZZ", t: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.FixedZone("", -3*60*60)), want: "2021-02-03T04:05:06.789-03:00"},
+		{name: "Locale week and Epoch milliseconds", format: "iiii-nn x", t: time.Date(2020, time.December, 27, 0, 0, 0, 789000000, time.UTC), want: "2021-01 1609027200789"},
+		{name: "Names and unpadded fields", format: "EEEE, d MMMM h:mm a", t: time.Date(2021, time.February, 3, 16, 5, 0, 0, time.UTC), want: "Wednesday, 3 February 4:05 PM"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectLuxon)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_luxon(t *testing.T) {
+	got, err := Parse("yyyy-LL-dd #HH", "2021-02-03 #04", WithDialect(DialectLuxon))
+	if want := time.Date(2021, time.February, 3, 4, 0, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestConvert_luxon(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		from, to       *Dialect
+		want           string
+		wantSpecs      []string
+		wantCollisions []string
+	}{
+		{name: "Luxon to strftime", format: "ccc, dd LLL yyyy HH:mm:ss ZZZ", from: DialectLuxon, to: DialectC, want: "%a, %d %b %Y %H:%M:%S %z"},
+		{name: "Luxon tokens without an equivalent", format: "DDD q S z", from: DialectLuxon, to: DialectC, wantSpecs: []string{"DDD", "q", "S", "z"}},
+		{name: "strftime to Luxon", format: "%A, %e %B %Y at %-I:%M %p", from: DialectC, to: DialectLuxon, want: "EEEE, d MMMM yyyy 'at' h:mm a"},
+		{name: "strftime week date to Luxon", format: "%0G-W%0V-%u", from: DialectC, to: DialectLuxon, want: "kkkk-'W'WW-E"},
+		{name: "strftime locale week date to Luxon", format: "%J-%K", from: DialectC, to: DialectLuxon, want: "iiii-nn"},
+		{name: "Elasticsearch Epoch milliseconds to Luxon", format: "epoch_millis", from: DialectElasticsearch, to: DialectLuxon, want: "x"},
+		{name: "strftime single quote to Luxon", format: "%H o'clock", from: DialectC, to: DialectLuxon, wantCollisions: []string{" o'clock"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil && tt.wantCollisions == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) || !reflect.DeepEqual(cerr.Collisions, tt.wantCollisions) {
+				t.Errorf("Convert() error = %v, want specs %q and collisions %q", err, tt.wantSpecs, tt.wantCollisions)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package strftime
+
+import (
+	"sort"
+	"strings"
+)
+
+// DialectMoment is the format token language of the moment.js and Day.js libraries, as in ddd, DD MMM YYYY. Text in
+// square brackets is literal, a backslash escapes the character following it and characters that are not part of a
+// token are copied. Do writes the day with its English ordinal suffix and x the milliseconds since the Epoch. Tokens
+// without a strftime equivalent, such as the other ordinals, quarters (Q), eras (N), the two digit locale week year
+// (gg), k and the localized formats (L, LT), are reported as errors.
+var DialectMoment = &Dialect{
+	name:    "moment",
+	target:  "a moment.js format",
+	compile: compileMoment,
+	render:  renderMoment,
+}
+
+// momentSpecs holds the compiled equivalents of the moment.js format tokens.
+var momentSpecs = func() map[string][]item {
+	specs := specTable(map[string]string{
+		"M": "%-m", "MM": "%m", "MMM": "%b", "MMMM": "%B",
+		"D": "%-d", "DD": "%d", "DDD": "%-j", "DDDD": "%j",
+		"d": "%w", "ddd": "%a", "dddd": "%A", "E": "%u",
+		"w": "%-K", "ww": "%K", "W": "%-V", "WW": "%0V",
+		"Y": "%-Y", "YY": "%y", "YYYY": "%Y", "YYYYY": "%5Y", "GG": "%g", "GGGG": "%0G", "gggg": "%J",
+		"A": "%p", "a": "%P", "H": "%-H", "HH": "%H", "h": "%-I", "hh": "%I",
+		"Hmm": "%-H%M", "Hmmss": "%-H%M%S", "hmm": "%-I%M", "hmmss": "%-I%M%S",
+		"m": "%-M", "mm": "%M", "s": "%-S", "ss": "%S",
+		"S": "%1N", "SS": "%2N", "SSS": "%3N", "SSSS": "%4N", "SSSSS": "%5N", "SSSSSS": "%6N", "SSSSSSS": "%7N",
+		"SSSSSSSS": "%8N", "SSSSSSSSS": "%9N",
+		"z": "%Z", "zz": "%Z", "Z": "%:z", "ZZ": "%z", "X": "%s",
+	})
+	specs["Do"] = []item{{spec: 'd', pad: '-'}, {spec: specDaySuffix}}
+	specs["x"] = []item{{spec: specUnixMilli}}
+
+	return specs
+}()
+
+// momentAliases holds the tokens that are read but not written.
+var momentAliases = map[string]bool{"Hmm": true, "Hmmss": true, "hmm": true, "hmmss": true, "zz": true}
+
+// momentTokens holds the moment.js format tokens, longest first.
+var momentTokens = func() []string {
+	tokens := []string{
+		"Mo", "DDDo", "dd", "do", "e", "wo", "Wo", "Q", "Qo", "N", "NN", "NNN", "NNNN", "NNNNN", "YYYYYY",
+		"y", "yy", "yyy", "yyyy", "yo", "gg", "ggggg", "GGGGG", "k", "kk",
+		"LT", "LTS", "L", "LL", "LLL", "LLLL", "l", "ll", "lll", "llll",
+	}
+	for token := range momentSpecs {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if len(tokens[i]) != len(tokens[j]) {
+			return len(tokens[i]) > len(tokens[j])
+		}
+		return tokens[i] < tokens[j]
+	})
+
+	return tokens
+}()
+
+func compileMoment(f string, _ *Locale) ([]item, error) {
+	cerr := &ConversionError{Format: f, Target: "a strftime format"}
+
+	var items []item
+	for i := 0; i < len(f); {
+		if f[i] == '[' {
+			if j := strings.IndexAny(f[i+1:], "[]"); j >= 0 && f[i+1+j] == ']' {
+				items = appendText(items, f[i+1:i+1+j])
+				i += j + 2
+				continue
+			}
+		}
+		if f[i] == '\\' && i+1 < len(f) {
+			items = appendText(items, f[i+1:i+2])
+			i += 2
+			continue
+		}
+
+		token := momentToken(f[i:])
+		if token == "" {
+			items = appendText(items, f[i:i+1])
+			i++
+			continue
+		}
+		i += len(token)
+
+		spec, found := momentSpecs[token]
+		if !found {
+			cerr.Specs = append(cerr.Specs, token)
+			items = appendText(items, token)
+			continue
+		}
+		items = append(items, spec...)
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+// momentToken returns the format token at the start of s, or "" if there is none.
+func momentToken(s string) string {
+	for _, token := range momentTokens {
+		if strings.HasPrefix(s, token) {
+			return token
+		}
+	}
+
+	return ""
+}
+
+func renderMoment(items []item, cerr *ConversionError) string {
+	specs := make(map[string][]item, len(momentSpecs))
+	for token, spec := range momentSpecs {
+		if !momentAliases[token] {
+			specs[token] = spec
+		}
+	}
+
+	return renderSpecs(items, specs, quoteMoment, cerr)
+}
+
+// quoteMoment puts the runs of ASCII letters of literal text in square brackets and escapes square brackets and
+// backslashes with a backslash.
+func quoteMoment(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		if c := text[i]; !isASCIILetter(c) {
+			if c == '[' || c == ']' || c == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(text) && isASCIILetter(text[i+n]) {
+			n++
+		}
+		b.WriteString("[" + text[i:i+n] + "]")
+		i += n
+	}
+
+	return b.String()
+}
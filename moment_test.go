@@ -0,0 +1,74 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_moment(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "Names", format: "ddd, DD MMM YYYY", t: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC), want: "Wed, 03 Feb 2021"},
+		{name: "Ordinal day and bracketed text", format: "dddd [the] Do [of] MMMM", t: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC), want: "Monday the 1st of February"},
+		{name: "Time with fractional seconds and offset", format: "h:mm:ss.SSS A Z", t: time.Date(2021, time.February, 3, 16, 5, 6, 789000000, time.FixedZone("", 2*60*60)), want: "4:05:06.789 PM +02:00"},
+		{name: "Locale week and Epoch milliseconds", format: "gggg-ww x", t: time.Date(2020, time.December, 27, 0, 0, 0, 789000000, time.UTC), want: "2021-01 1609027200789"},
+		{name: "ISO week and escaped character", format: "GGGG-[W]WW-E \\Y", t: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), want: "2020-W53-5 Y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectMoment)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_moment(t *testing.T) {
+	got, err := Parse("YYYY-MM-DD[T]HH:mm:ss.SSSZ", "2021-02-03T04:05:06.789+01:00", WithDialect(DialectMoment))
+	if want := time.Date(2021, time.February, 3, 3, 5, 6, 789000000, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestConvert_moment(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "moment to strftime", format: "ddd, DD MMM YYYY HH:mm:ss", from: DialectMoment, to: DialectC, want: "%a, %d %b %Y %H:%M:%S"},
+		{name: "moment literals to strftime", format: `[Today is] dddd \[D\]`, from: DialectMoment, to: DialectC, want: "Today is %A [%-d]"},
+		{name: "moment tokens without an equivalent", format: "Qo LT Mo gg", from: DialectMoment, to: DialectC, wantSpecs: []string{"Qo", "LT", "Mo", "gg"}},
+		{name: "moment Epoch milliseconds to strftime", format: "x", from: DialectMoment, to: DialectC, wantSpecs: []string{"<milliseconds since the Epoch>"}},
+		{name: "strftime to moment", format: "%Y-%m-%dT%H:%M:%S.%3N%:z", from: DialectC, to: DialectMoment, want: "YYYY-MM-DD[T]HH:mm:ss.SSSZ"},
+		{name: "strftime unpadded fields to moment", format: "%e/%-m %k:%M %P", from: DialectC, to: DialectMoment, want: "D/M H:mm a"},
+		{name: "strftime brackets to moment", format: "[%H]", from: DialectC, to: DialectMoment, want: `\[HH\]`},
+		{name: "strftime specifications without an equivalent", format: "%C %U", from: DialectC, to: DialectMoment, wantSpecs: []string{"%C", "%U"}},
+		{name: "strftime locale week date to moment", format: "%J-%K", from: DialectC, to: DialectMoment, want: "gggg-ww"},
+		{name: "PHP to moment", format: "jS F Y", from: DialectPHP, to: DialectMoment, want: "Do MMMM YYYY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package strftime
+
+import "strings"
+
+// DialectMySQL is the format language of the MySQL DATE_FORMAT and STR_TO_DATE functions. It differs from strftime
+// in %i (minutes), %s (seconds), %M (month name), %W (weekday name), %D (day with English suffix, as in 1st), %f
+// (microseconds) and %h (hour 01-12), and in the week numbers of %u, %V and %X, which follow the week modes 1 and 2
+// of the WEEK function. A % followed by any other character stands for that character.
+var DialectMySQL = &Dialect{
+	name:    "MySQL",
+	target:  "a MySQL format",
+	compile: compileMySQL,
+	render:  renderMySQL,
+}
+
+// mysqlSpecs holds the compiled equivalents of the MySQL format specifiers.
+var mysqlSpecs = map[string][]item{
+	"a": {{spec: 'a'}},
+	"b": {{spec: 'b'}},
+	"c": {{spec: 'm', pad: '-'}},
+	"D": {{spec: 'd', pad: '-'}, {spec: specDaySuffix}},
+	"d": {{spec: 'd'}},
+	"e": {{spec: 'd', pad: '-'}},
+	"f": {{spec: 'N', width: 6}},
+	"H": {{spec: 'H'}},
+	"h": {{spec: 'I'}},
+	"I": {{spec: 'I'}},
+	"i": {{spec: 'M'}},
+	"j": {{spec: 'j'}},
+	"k": {{spec: 'H', pad: '-'}},
+	"l": {{spec: 'I', pad: '-'}},
+	"M": {{spec: 'B'}},
+	"m": {{spec: 'm'}},
+	"p": {{spec: 'p'}},
+	"r": {{spec: 'I'}, {text: ":"}, {spec: 'M'}, {text: ":"}, {spec: 'S'}, {text: " "}, {spec: 'p'}},
+	"S": {{spec: 'S'}},
+	"s": {{spec: 'S'}},
+	"T": {{spec: 'H'}, {text: ":"}, {spec: 'M'}, {text: ":"}, {spec: 'S'}},
+	"U": {{spec: 'U'}},
+	"u": {{spec: specMondayWeek}},
+	"V": {{spec: specSundayWeek}},
+	"v": {{spec: 'V', pad: '0'}},
+	"W": {{spec: 'A'}},
+	"w": {{spec: 'w'}},
+	"X": {{spec: specSundayWeekYear}},
+	"x": {{spec: 'G'}},
+	"Y": {{spec: 'Y'}},
+	"y": {{spec: 'y'}},
+}
+
+func compileMySQL(f string, _ *Locale) ([]item, error) {
+	var items []item
+	for i := 0; i < len(f); i++ {
+		j := strings.IndexByte(f[i:], '%')
+		if j < 0 {
+			return appendText(items, f[i:]), nil
+		}
+		if j > 0 {
+			items = appendText(items, f[i:i+j])
+			i += j
+		}
+
+		if i+1 == len(f) {
+			return appendText(items, "%"), nil
+		}
+		i++
+
+		spec, found := mysqlSpecs[f[i:i+1]]
+		if !found {
+			items = appendText(items, f[i:i+1])
+			continue
+		}
+		for _, it := range spec {
+			if it.spec == 0 {
+				items = appendText(items, it.text)
+				continue
+			}
+			items = append(items, it)
+		}
+	}
+
+	return items, nil
+}
+
+func renderMySQL(items []item, cerr *ConversionError) string {
+	specs := make(map[string][]item, len(mysqlSpecs))
+	for key, spec := range mysqlSpecs {
+		specs["%"+key] = spec
+	}
+
+	return renderSpecs(items, specs, func(text string) string {
+		return strings.ReplaceAll(text, "%", "%%")
+	}, cerr)
+}
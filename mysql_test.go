@@ -0,0 +1,93 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_mysql(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "Names and ordinal day", format: "%W, %M %D %Y", t: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC), want: "Wednesday, February 3rd 2021"},
+		{name: "Ordinal suffixes", format: "%D %D %D %D", t: time.Date(2021, time.February, 11, 0, 0, 0, 0, time.UTC), want: "11th 11th 11th 11th"},
+		{name: "Minutes, seconds and microseconds", format: "%H:%i:%s.%f", t: time.Date(2021, time.February, 3, 4, 5, 6, 123456789, time.UTC), want: "04:05:06.123456"},
+		{name: "Unpadded fields", format: "%e.%c. %k %l", t: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC), want: "3.2. 16 4"},
+		{name: "Composite specifiers", format: "%r %T", t: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC), want: "04:05:06 PM 16:05:06"},
+		{name: "Week modes before week 1", format: "%u %U %V %X %v %x", t: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), want: "00 00 52 2020 53 2020"},
+		{name: "Sunday week on the first Sunday", format: "%U", t: time.Date(2019, time.January, 6, 0, 0, 0, 0, time.UTC), want: "01"},
+		{name: "Sunday week on the second Sunday", format: "%U", t: time.Date(2019, time.January, 13, 0, 0, 0, 0, time.UTC), want: "02"},
+		{name: "Week modes at the end of the year", format: "%u %V %X", t: time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC), want: "53 52 2018"},
+		{name: "Unknown specifiers stand for the character", format: "%q%%%", t: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC), want: "q%%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectMySQL)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_mysql(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		value  string
+		want   time.Time
+	}{
+		{name: "Ordinal day and month name", format: "%D of %M, %Y %H:%i:%s.%f", value: "3rd of february, 2021 04:05:06.123456", want: time.Date(2021, time.February, 3, 4, 5, 6, 123456000, time.UTC)},
+		{name: "12-hour clock", format: "%Y-%m-%d %r", value: "2021-02-03 04:05:06 PM", want: time.Date(2021, time.February, 3, 16, 5, 6, 0, time.UTC)},
+		{name: "Week of the week based year", format: "%X %V %W", value: "2020 52 Friday", want: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Week 0 with Monday as first day", format: "%Y %u %a", value: "2021 00 Fri", want: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, tt.value, WithDialect(DialectMySQL))
+			if err != nil || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_mysql(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "MySQL to C", format: "%Y-%m-%d %H:%i:%s", from: DialectMySQL, to: DialectC, want: "%Y-%m-%d %H:%M:%S"},
+		{name: "MySQL unpadded fields to C", format: "%e %c %k %l %f", from: DialectMySQL, to: DialectC, want: "%-d %-m %-H %-I %6N"},
+		{name: "MySQL composites to C", format: "%r, 100%%", from: DialectMySQL, to: DialectC, want: "%I:%M:%S %p, 100%%"},
+		{name: "MySQL ordinal day to C", format: "%D %M", from: DialectMySQL, to: DialectC, wantSpecs: []string{"<day of month suffix>"}},
+		{name: "C to MySQL", format: "%F %T", from: DialectC, to: DialectMySQL, want: "%Y-%m-%d %T"},
+		{name: "C names and 12-hour time to MySQL", format: "%A, %B %e %I:%M:%S %p", from: DialectC, to: DialectMySQL, want: "%W, %M %e %r"},
+		{name: "C fractional seconds to MySQL", format: "%S.%6N %%", from: DialectC, to: DialectMySQL, want: "%S.%f %%"},
+		{name: "C specifications without an equivalent", format: "%s %N %Z", from: DialectC, to: DialectMySQL, wantSpecs: []string{"%s", "%N", "%Z"}},
+		{name: "ICU to MySQL", format: "d MMM yyyy", from: DialectICU, to: DialectMySQL, want: "%e %b %Y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+//go:build !race
+
+package strftime
+
+const raceEnabled = false
@@ -0,0 +1,78 @@
+package strftime
+
+import (
+	"fmt"
+	"time"
+)
+
+// An Option configures how Format and Parse interpret a format string.
+type Option func(*options)
+
+type options struct {
+	locale   *Locale
+	dialect  *Dialect
+	location *time.Location
+	strict   bool
+	err      error
+}
+
+func newOptions(opts []Option) options {
+	o := options{locale: EnUS, dialect: DialectC}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// WithLocale sets the locale used for the names and preferred representations of the conversion specifications.
+// The default locale is EnUS.
+func WithLocale(l *Locale) Option {
+	return func(o *options) {
+		if l != nil {
+			o.locale = l
+		}
+	}
+}
+
+// WithLocaleName selects a locale registered with RegisterLocale by name. Parse, Compile and FormatContext report an
+// error for an unknown name. Format has no way to report it and silently uses the locale selected by the preceding
+// options, EnUS by default.
+func WithLocaleName(name string) Option {
+	return func(o *options) {
+		if l, found := LookupLocale(name); found {
+			o.locale = l
+			return
+		}
+		o.err = fmt.Errorf("strftime: unknown locale %q", name)
+	}
+}
+
+// WithDialect sets the format language of the format string. The default dialect is DialectC.
+func WithDialect(d *Dialect) Option {
+	return func(o *options) {
+		if d != nil {
+			o.dialect = d
+		}
+	}
+}
+
+// WithLocation sets the time zone of formatted and parsed times. Format converts times to loc before formatting them,
+// Parse returns values without time zone information in loc, instead of UTC, and times since the Epoch in loc.
+// Abbreviations and offsets in values are looked up in loc instead of the local time zone.
+func WithLocation(loc *time.Location) Option {
+	return func(o *options) {
+		if loc != nil {
+			o.location = loc
+		}
+	}
+}
+
+// WithStrict makes Parse and FormatContext report unknown conversion specifications in a *FormatError, as Compile
+// does, instead of reading them as literal text. Format has no way to report them and always reads them as literal
+// text.
+func WithStrict(strict bool) Option {
+	return func(o *options) {
+		o.strict = strict
+	}
+}
@@ -0,0 +1,274 @@
+package strftime
+
+import (
+	"sort"
+	"strings"
+)
+
+// DialectOracle is the format model language of the Oracle TO_CHAR and TO_DATE functions, as in DD-MON-RR HH24:MI:SS
+// or YYYY-MM-DD"T"HH24:MI:SS.FF3. Format elements are matched without regard to case, text in double quotes is
+// literal and the punctuation - / , . ; : and spaces are copied. The FM modifier toggles fill mode, which suppresses
+// the padding of numbers and names for the elements following it, the FX modifier is accepted and has no effect.
+//
+// RR reads two digit years in a window around the current year: with the current year in the first half of its
+// century, 50 to 99 fall in the previous century; in the second half, 00 to 49 fall in the next century. RRRR reads
+// four digit years and two digit years as RR. The case of MON, DY, AM and the TH suffix of DD selects the case of the
+// output, as in MON for JAN and Mon for Jan. MONTH, Month, DAY and Day are only supported in fill mode.
+//
+// Format elements without a strftime equivalent are reported as errors, among them SYYYY, Y,YYY, YYY, Y, IYY, I, W,
+// WW, CC, SCC, Q, D, J, RM, SSSSS, TZR, the era indicators, the TS, DS and DL formats and the SP suffix.
+var DialectOracle = &Dialect{
+	name:    "Oracle",
+	target:  "an Oracle format model",
+	compile: compileOracle,
+	render:  renderOracle,
+}
+
+// oracleElements holds the compiled equivalents of the format elements, keyed by the element as written in a format
+// model. Elements starting with FM are only available in fill mode. Rendering prefers them to the oracleAliases,
+// which are only read.
+var oracleElements = map[string][]item{
+	"YYYY":    {{spec: 'Y'}},
+	"YY":      {{spec: 'y'}},
+	"RRRR":    {{spec: specYearRRRR}},
+	"RR":      {{spec: specYearRR}},
+	"IYYY":    {{spec: 'G', pad: '0'}},
+	"IY":      {{spec: 'g'}},
+	"IW":      {{spec: 'V', pad: '0'}},
+	"MM":      {{spec: 'm'}},
+	"MON":     {{spec: 'b', casing: '^'}},
+	"Mon":     {{spec: 'b'}},
+	"FMMONTH": {{spec: 'B', casing: '^'}},
+	"FMMonth": {{spec: 'B'}},
+	"DD":      {{spec: 'd'}},
+	"DDTH":    {{spec: 'd'}, {spec: specDaySuffix, casing: '^'}},
+	"DDth":    {{spec: 'd'}, {spec: specDaySuffix}},
+	"DDD":     {{spec: 'j'}},
+	"DY":      {{spec: 'a', casing: '^'}},
+	"Dy":      {{spec: 'a'}},
+	"FMDAY":   {{spec: 'A', casing: '^'}},
+	"FMDay":   {{spec: 'A'}},
+	"HH":      {{spec: 'I'}},
+	"HH24":    {{spec: 'H'}},
+	"MI":      {{spec: 'M'}},
+	"SS":      {{spec: 'S'}},
+	"FF":      {{spec: 'N'}},
+	"FF1":     {{spec: 'N', width: 1}},
+	"FF2":     {{spec: 'N', width: 2}},
+	"FF3":     {{spec: 'N', width: 3}},
+	"FF4":     {{spec: 'N', width: 4}},
+	"FF5":     {{spec: 'N', width: 5}},
+	"FF6":     {{spec: 'N', width: 6}},
+	"FF7":     {{spec: 'N', width: 7}},
+	"FF8":     {{spec: 'N', width: 8}},
+	"AM":      {{spec: 'p'}},
+	"am":      {{spec: 'P'}},
+	"TZD":     {{spec: 'Z'}},
+	"TZH:TZM": {{spec: 'z', colons: 1}},
+}
+
+var oracleAliases = map[string][]item{
+	"HH12": {{spec: 'I'}},
+	"FF9":  {{spec: 'N', width: 9}},
+	"PM":   {{spec: 'p'}},
+	"pm":   {{spec: 'P'}},
+	"X":    {{text: "."}},
+}
+
+// oracleKeywords holds the format elements recognized in a format model, longest first.
+var oracleKeywords = func() []string {
+	keywords := []string{
+		"TZH:TZM", "TZH", "TZM", "TZR", "TZD",
+		"SYYYY", "Y,YYY", "YYYY", "YYY", "YY", "Y", "RRRR", "RR", "IYYY", "IYY", "IY", "I", "IW", "WW", "W",
+		"SCC", "CC", "Q", "MM", "MONTH", "MON", "DAY", "DY", "DDD", "DD", "D", "J", "RM",
+		"HH24", "HH12", "HH", "MI", "SSSSS", "SS",
+		"FF1", "FF2", "FF3", "FF4", "FF5", "FF6", "FF7", "FF8", "FF9", "FF",
+		"A.M.", "P.M.", "AM", "PM", "B.C.", "A.D.", "BC", "AD", "EE", "E", "TS", "DS", "DL", "X",
+	}
+	sort.SliceStable(keywords, func(i, j int) bool { return len(keywords[i]) > len(keywords[j]) })
+
+	return keywords
+}()
+
+// oracleNames holds the format elements whose case selects the case of the output.
+var oracleNames = map[string]bool{"MONTH": true, "MON": true, "DAY": true, "DY": true, "AM": true, "PM": true}
+
+func compileOracle(f string, _ *Locale) ([]item, error) {
+	cerr := &ConversionError{Format: f, Target: "a strftime format"}
+
+	var items []item
+	fill := false
+	for i := 0; i < len(f); {
+		if f[i] == '"' {
+			text, n := unquotePostgreSQL(f[i:])
+			items = appendText(items, text)
+			i += n
+			continue
+		}
+		if i+2 <= len(f) {
+			if prefix := strings.ToUpper(f[i : i+2]); prefix == "FM" || prefix == "FX" {
+				fill = fill != (prefix == "FM")
+				i += 2
+				continue
+			}
+		}
+
+		keyword := oracleKeyword(f[i:])
+		if keyword == "" {
+			// Letters and digits that are not format elements are errors, other characters are copied.
+			if isASCIILetter(f[i]) || isDigit(f[i]) {
+				cerr.Specs = append(cerr.Specs, f[i:i+1])
+			}
+			items = appendText(items, f[i:i+1])
+			i++
+			continue
+		}
+		token := f[i : i+len(keyword)]
+		i += len(keyword)
+
+		spec := oracleSpec(keyword, token, fill)
+		suffix := ""
+		switch {
+		case strings.HasPrefix(strings.ToUpper(f[i:]), "SPTH"), strings.HasPrefix(strings.ToUpper(f[i:]), "THSP"):
+			suffix = f[i : i+4]
+		case strings.HasPrefix(strings.ToUpper(f[i:]), "TH"), strings.HasPrefix(strings.ToUpper(f[i:]), "SP"):
+			suffix = f[i : i+2]
+		}
+		if suffix != "" {
+			token += suffix
+			i += len(suffix)
+			if keyword == "DD" && (suffix == "TH" || suffix == "th") {
+				spec = append(spec[:len(spec):len(spec)], oracleElements["DD"+suffix][1])
+			} else {
+				spec = nil
+			}
+		}
+		if spec == nil {
+			cerr.Specs = append(cerr.Specs, token)
+			items = appendText(items, token)
+			continue
+		}
+		for _, it := range spec {
+			if it.spec == 0 {
+				items = appendText(items, it.text)
+				continue
+			}
+			items = append(items, it)
+		}
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+// oracleKeyword returns the format element at the start of s, matched without regard to case, or "" if there is
+// none.
+func oracleKeyword(s string) string {
+	for _, keyword := range oracleKeywords {
+		if len(s) >= len(keyword) && strings.EqualFold(s[:len(keyword)], keyword) {
+			return keyword
+		}
+	}
+
+	return ""
+}
+
+// oracleSpec returns the compiled equivalent of a format element written as token, in or out of fill mode, nil if
+// there is none.
+func oracleSpec(keyword, token string, fill bool) []item {
+	key := keyword
+	if oracleNames[keyword] {
+		// The case of names follows the element: upper case, capitalized or, for AM and PM, lower case.
+		switch token {
+		case keyword:
+		case keyword[:1] + strings.ToLower(keyword[1:]):
+			key = token
+		case strings.ToLower(keyword):
+			if keyword != "AM" && keyword != "PM" {
+				return nil
+			}
+			key = token
+		default:
+			return nil
+		}
+	}
+	if fill {
+		if spec, found := oracleElements["FM"+key]; found {
+			return spec
+		}
+	}
+
+	spec, found := oracleElements[key]
+	if !found {
+		spec, found = oracleAliases[key]
+	}
+	if !found || !fill {
+		return spec
+	}
+
+	if _, number := numberSpecs[spec[0].spec]; number {
+		spec = append([]item(nil), spec...)
+		spec[0].pad = '-'
+	}
+
+	return spec
+}
+
+func renderOracle(items []item, cerr *ConversionError) string {
+	var b strings.Builder
+	fill := false
+	for len(items) > 0 {
+		if items[0].spec == 0 {
+			b.WriteString(quoteOracle(items[0].text))
+			items = items[1:]
+			continue
+		}
+
+		// Numbers are written in the fill mode matching their padding, names only available in fill mode switch it
+		// on and other elements keep the current mode.
+		key, n := matchSpecs(items, oracleElements)
+		_, number := numberSpecs[items[0].spec]
+		wantFill := fill
+		switch {
+		case n > 0 && strings.HasPrefix(key, "FM"):
+			key, wantFill = key[2:], true
+		case n > 0 && number:
+			wantFill = false
+		case n == 0:
+			// Unpadded numbers are the zero padded elements in fill mode.
+			it := canonical(items[0])
+			if def, number := numberSpecs[it.spec]; number && padding(it.pad, def.pad) == '-' {
+				it.pad = '0'
+				if key, n = matchSpecs(append([]item{it}, items[1:]...), oracleElements); n > 0 {
+					wantFill = true
+				}
+			}
+		}
+		if n == 0 {
+			cerr.Specs = append(cerr.Specs, items[0].String())
+			items = items[1:]
+			continue
+		}
+		if wantFill != fill {
+			b.WriteString("FM")
+			fill = wantFill
+		}
+		b.WriteString(key)
+		items = items[n:]
+	}
+
+	return b.String()
+}
+
+// quoteOracle quotes literal text containing characters other than the punctuation a format model copies. Double
+// quotes are escaped with a backslash.
+func quoteOracle(text string) string {
+	if strings.Trim(text, " -/,.;:") == "" {
+		return text
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
@@ -0,0 +1,114 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConvert_oracle(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "ISO 8601 to strftime", format: `YYYY-MM-DD"T"HH24:MI:SS.FF3TZH:TZM`, from: DialectOracle, to: DialectC, want: "%Y-%m-%dT%H:%M:%S.%3N%:z"},
+		{name: "Lower case numeric elements", format: "yyyy-mm-dd hh24:mi:ss.ff", from: DialectOracle, to: DialectC, want: "%Y-%m-%d %H:%M:%S.%N"},
+		{name: "Fill mode toggles", format: "FMDD.MM.FMYYYY HH12", from: DialectOracle, to: DialectC, want: "%-d.%-m.%Y %I"},
+		{name: "FX modifier", format: "FXDD/MM/YYYY", from: DialectOracle, to: DialectC, want: "%d/%m/%Y"},
+		{name: "Names in fill mode", format: "FMDAY Day DY Dy MONTH Month MON Mon", from: DialectOracle, to: DialectC, want: "%^A %A %^a %a %^B %B %^b %b"},
+		{name: "ISO week date and meridiem", format: "IYYY-IW HH:MI am PM", from: DialectOracle, to: DialectC, want: "%0G-%0V %I:%M %P %p"},
+		{name: "RR year", format: "DD-MON-RR", from: DialectOracle, to: DialectC, wantSpecs: []string{"<year in century with Oracle RR window>"}},
+		{name: "Ordinal day", format: "DDth", from: DialectOracle, to: DialectC, wantSpecs: []string{"<day of month suffix>"}},
+		{name: "Elements without an equivalent", format: "MONTH mon Y,YYY WW Q TZR DDSP A.M. G", from: DialectOracle, to: DialectC, wantSpecs: []string{"MONTH", "mon", "Y,YYY", "WW", "Q", "TZR", "DDSP", "A.M.", "G"}},
+		{name: "strftime to format model", format: "%Y-%m-%dT%H:%M:%S.%6N%:z", from: DialectC, to: DialectOracle, want: `YYYY-MM-DD"T"HH24:MI:SS.FF6TZH:TZM`},
+		{name: "strftime unpadded fields to fill mode", format: "%e.%-m.%Y %k:%M", from: DialectC, to: DialectOracle, want: "FMDD.MM.FMYYYY FMHH24:FMMI"},
+		{name: "strftime names", format: "%A, %B %^a %b %p %Z", from: DialectC, to: DialectOracle, want: "FMDay, Month DY Mon AM TZD"},
+		{name: "strftime literal text", format: `%H o'clock "sharp"`, from: DialectC, to: DialectOracle, want: `HH24" o'clock \"sharp\""`},
+		{name: "strftime specifications without an equivalent", format: "%s %U %C %_d %z", from: DialectC, to: DialectOracle, wantSpecs: []string{"%s", "%U", "%C", "%_d", "%z"}},
+		{name: "PostgreSQL ordinal day to format model", format: "FMDDth FMMonth YYYY", from: DialectPostgreSQL, to: DialectOracle, want: "FMDDth Month FMYYYY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func TestFormat_oracle(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "Default date format", format: "DD-MON-RR HH24:MI:SS", t: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC), want: "03-FEB-21 04:05:06"},
+		{name: "Timestamp", format: `YYYY-MM-DD"T"HH24:MI:SS.FF3`, t: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC), want: "2021-02-03T04:05:06.789"},
+		{name: "Fill mode and ordinal day", format: "FMDay, DDTH Month YYYY HH12:MI am", t: time.Date(2021, time.February, 22, 16, 5, 0, 0, time.UTC), want: "Monday, 22ND February 2021 4:5 pm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectOracle)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_oracle(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name   string
+		format string
+		value  string
+		want   time.Time
+	}{
+		{name: "RR in current century", format: "DD-MON-RR HH24:MI:SS", value: "03-FEB-21 04:05:06", want: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)},
+		{name: "RR in previous century", format: "DD-MON-RR", value: "03-feb-85", want: time.Date(1985, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "RRRR with two digits", format: "DD.MM.RRRR", value: "03.02.49", want: time.Date(2049, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "RRRR with four digits", format: "DD.MM.RRRR", value: "03.02.1949", want: time.Date(1949, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Timestamp", format: `YYYY-MM-DD"T"HH24:MI:SS.FF3`, value: "2021-02-03T04:05:06.789", want: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.format, tt.value, WithDialect(DialectOracle))
+			if err != nil || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rrYear(t *testing.T) {
+	tests := []struct {
+		yy, current, want int
+	}{
+		{yy: 21, current: 2021, want: 2021},
+		{yy: 85, current: 2021, want: 1985},
+		{yy: 49, current: 2049, want: 2049},
+		{yy: 50, current: 2049, want: 1950},
+		{yy: 49, current: 2050, want: 2149},
+		{yy: 99, current: 2099, want: 2099},
+	}
+	for _, tt := range tests {
+		if got := rrYear(tt.yy, tt.current); got != tt.want {
+			t.Errorf("rrYear(%d, %d) = %d, want %d", tt.yy, tt.current, got, tt.want)
+		}
+	}
+}
@@ -1,11 +1,718 @@
 package strftime
 
-import "time"
+import (
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
 
 // Parse parses a formatted string and returns the time.Time value it represents.
 // The format defines the input value format using C strftime(3) conversion specifications.
-func Parse(format, value string) (time.Time, error) {
-	layout := parseFormat(format, convSpecs)
+//
+// As with strptime(3), white space in the format matches zero or more white space characters in the value, and
+// names of days, months and the AM/PM designation are matched without regard to case. %a and %A accept both the
+// full and the abbreviated weekday name, %b, %B and %h both the full and the abbreviated month name. Values without
+// time zone information are returned in UTC, or in the location set with WithLocation. The WithDialect option selects
+// another format language.
+func Parse(format, value string, opts ...Option) (time.Time, error) {
+	o := newOptions(opts)
+	if o.err != nil {
+		return time.Time{}, o.err
+	}
+	items, err := o.dialect.compile(format, o.locale)
+	if err != nil && (o.strict || !lenient(err)) {
+		return time.Time{}, err
+	}
 
-	return time.Parse(layout, value)
+	return parseItems(format, value, items, o.locale, o.location)
+}
+
+func parseItems(format, value string, items []item, l *Locale, loc *time.Location) (time.Time, error) {
+	p := newParser(format, value, l)
+	p.loc = loc
+	if err := p.parse(items); err != nil {
+		return time.Time{}, err
+	}
+
+	return p.time()
+}
+
+// now returns the current time. It is replaced in tests.
+var now = time.Now
+
+// parser holds the input remaining to be parsed and the fields parsed from it so far.
+type parser struct {
+	format string
+	value  string
+	rest   string
+	l      *Locale
+	loc    *time.Location
+
+	year, century, yearInCentury  int
+	isoYear, isoYearInCentury     int
+	month, day, yearDay           int
+	weekday, isoWeekday           int
+	sundayWeek, mondayWeek        int
+	isoWeek                       int
+	week, weekYear, localeWeekday int
+	weekFirst                     time.Weekday
+	weekMinDays                   int
+	hour, minute, second, nsec    int
+	pm                            int
+	era                           *Era
+	eraYear                       int
+	unix                          int64
+	hasUnix                       bool
+	rr                            bool
+
+	zoneOffset int
+	zoneName   string
+	utc        bool
+}
+
+func newParser(format, value string, l *Locale) *parser {
+	return &parser{
+		format: format, value: value, rest: value, l: l,
+		year: -1, century: -1, yearInCentury: -1,
+		isoYear: -1, isoYearInCentury: -1,
+		weekday: -1, isoWeekday: -1,
+		sundayWeek: -1, mondayWeek: -1, isoWeek: -1,
+		week: -1, weekYear: -1, localeWeekday: -1,
+		pm: -1, eraYear: -1, zoneOffset: -1,
+	}
+}
+
+func (p *parser) parse(items []item) error {
+	if err := p.items(items); err != nil {
+		return err
+	}
+
+	if p.rest != "" {
+		return p.error("", ": extra text: "+strconv.Quote(p.rest))
+	}
+
+	return nil
+}
+
+func (p *parser) items(items []item) error {
+	for _, it := range items {
+		if it.spec == 0 {
+			if err := p.literal(it.text); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := p.spec(it); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// literal consumes literal text. White space in the text matches any amount of white space in the value.
+func (p *parser) literal(text string) error {
+	for text != "" {
+		if isSpace(text[0]) {
+			text = text[1:]
+			p.skipSpace()
+			continue
+		}
+
+		if p.rest == "" || p.rest[0] != text[0] {
+			return p.error(text, "")
+		}
+		text = text[1:]
+		p.rest = p.rest[1:]
+	}
+
+	return nil
+}
+
+func (p *parser) spec(it item) error {
+	if it.mod == 'E' && len(p.l.Eras) > 0 {
+		return p.eraSpec(it)
+	}
+
+	var err error
+
+	switch spec := it.spec; spec {
+	case 'a', 'A':
+		p.weekday, err = p.name(spec, p.l.Days[:], p.l.ShortDays[:])
+	case 'b', 'B':
+		p.month, err = p.name(spec, p.l.Months[:], p.l.ShortMonths[:])
+		p.month++
+	case 'C':
+		p.century, err = p.number(spec, 2, 0, 99)
+	case 'd', 'e':
+		p.day, err = p.number(spec, 2, 1, 31)
+	case 'G':
+		p.isoYear, err = p.number(spec, 4, 0, 9999)
+	case 'g':
+		p.isoYearInCentury, err = p.number(spec, 2, 0, 99)
+	case 'H', 'k':
+		p.hour, err = p.number(spec, 2, 0, 23)
+	case 'I', 'l':
+		p.hour, err = p.number(spec, 2, 1, 12)
+	case 'j':
+		p.yearDay, err = p.number(spec, 3, 1, 366)
+	case 'm':
+		p.month, err = p.number(spec, 2, 1, 12)
+	case 'M':
+		p.minute, err = p.number(spec, 2, 0, 59)
+	case 'N':
+		p.nsec, err = p.fraction(spec, it.width)
+	case 'p', 'P':
+		p.pm, err = p.name(spec, []string{p.l.AM, p.l.PM}, nil)
+	case 's':
+		p.unix, err = p.signedNumber(spec)
+		p.hasUnix = true
+	case specUnixMilli:
+		var ms int64
+		ms, err = p.signedNumber(spec)
+		p.unix, p.nsec, p.hasUnix = ms/1000, int(ms%1000)*1e6, true
+		if p.nsec < 0 {
+			p.unix, p.nsec = p.unix-1, p.nsec+1e9
+		}
+	case 'S':
+		p.second, err = p.number(spec, 2, 0, 60)
+	case 'u':
+		p.isoWeekday, err = p.number(spec, 1, 1, 7)
+	case 'U':
+		p.sundayWeek, err = p.number(spec, 2, 0, 53)
+	case 'V':
+		p.isoWeek, err = p.number(spec, 2, 1, 53)
+	case 'w':
+		p.weekday, err = p.number(spec, 1, 0, 6)
+	case 'W':
+		p.mondayWeek, err = p.number(spec, 2, 0, 53)
+	case 'J':
+		p.weekYear, err = p.number(spec, 4, 0, 9999)
+	case 'K':
+		p.week, err = p.number(spec, 2, 1, 53)
+		p.weekFirst, p.weekMinDays = p.l.FirstWeekday, p.l.minDays()
+	case specMondayWeek:
+		p.week, err = p.number(spec, 2, 0, 53)
+		p.weekFirst, p.weekMinDays = time.Monday, 4
+	case specSundayWeek:
+		p.week, err = p.number(spec, 2, 1, 53)
+		p.weekFirst, p.weekMinDays = time.Sunday, 7
+	case specSundayWeekYear:
+		p.weekYear, err = p.number(spec, 4, 0, 9999)
+	case specDaySuffix:
+		_, err = p.name(spec, []string{"st", "nd", "rd", "th"}, nil)
+	case 'o':
+		p.localeWeekday, err = p.number(spec, 1, 1, 7)
+	case 'y':
+		p.yearInCentury, err = p.number(spec, 2, 0, 99)
+	case specYearRR:
+		p.yearInCentury, err = p.number(spec, 2, 0, 99)
+		p.rr = true
+	case specYearRRRR:
+		p.skipSpace()
+		n := len(p.rest)
+		if p.year, err = p.number(spec, 4, 0, 9999); err == nil && n-len(p.rest) <= 2 {
+			p.year, p.yearInCentury, p.rr = -1, p.year, true
+		}
+	case 'Y':
+		p.year, err = p.number(spec, 4, 0, 9999)
+	case 'z', specOffsetZ:
+		err = p.offset(spec)
+	case specUTC:
+		p.utc = true
+	case 'Z':
+		err = p.zone(spec)
+	}
+
+	return err
+}
+
+// eraSpec consumes the era name (%EC), the era year (%Ey) or the era year in the era's own format (%EY). The era
+// year is also accepted in the form of the era's first year name.
+func (p *parser) eraSpec(it item) error {
+	switch it.spec {
+	case 'C':
+		names := make([]string, len(p.l.Eras))
+		for i := range p.l.Eras {
+			names[i] = p.l.Eras[i].Name
+		}
+		i, err := p.name(it.spec, names, nil)
+		if err == nil {
+			p.era = &p.l.Eras[i]
+		}
+		return err
+	case 'y':
+		for i := range p.l.Eras {
+			if name := p.l.Eras[i].FirstYear; name != "" {
+				if n := matchFold(p.rest, name); n > 0 {
+					p.eraYear = 1
+					p.rest = p.rest[n:]
+					return nil
+				}
+			}
+		}
+		var err error
+		p.eraYear, err = p.number(it.spec, 4, 0, 9999)
+		return err
+	}
+
+	var err error
+	for i := range p.l.Eras {
+		saved := *p
+		if err = p.items(p.l.Eras[i].items(p.l)); err == nil {
+			if p.era == nil {
+				p.era = &p.l.Eras[i]
+			}
+			return nil
+		}
+		*p = saved
+	}
+
+	return err
+}
+
+// number consumes an unsigned decimal number of up to maxDigits digits, optionally preceded by white space, and
+// checks that it is within [min, max].
+func (p *parser) number(spec rune, maxDigits, min, max int) (int, error) {
+	p.skipSpace()
+
+	n := 0
+	for n < maxDigits && n < len(p.rest) && isDigit(p.rest[n]) {
+		n++
+	}
+	if n == 0 {
+		return 0, p.specError(spec, "")
+	}
+
+	v, _ := strconv.Atoi(p.rest[:n])
+	if v < min || v > max {
+		return 0, p.specError(spec, ": "+specRangeName(spec)+" out of range")
+	}
+	p.rest = p.rest[n:]
+
+	return v, nil
+}
+
+// fraction consumes fractional seconds of up to digits digits, up to nine if digits is 0, and returns them in
+// nanoseconds.
+func (p *parser) fraction(spec rune, digits int) (int, error) {
+	if digits == 0 {
+		digits = 9
+	}
+
+	n := 0
+	for n < digits && n < len(p.rest) && isDigit(p.rest[n]) {
+		n++
+	}
+	if n == 0 {
+		return 0, p.specError(spec, "")
+	}
+
+	nsec, _ := strconv.Atoi(p.rest[:n])
+	for i := n; i < 9; i++ {
+		nsec *= 10
+	}
+	p.rest = p.rest[n:]
+
+	return nsec, nil
+}
+
+func (p *parser) signedNumber(spec rune) (int64, error) {
+	p.skipSpace()
+
+	n := 0
+	if n < len(p.rest) && (p.rest[n] == '-' || p.rest[n] == '+') {
+		n++
+	}
+	for n < len(p.rest) && isDigit(p.rest[n]) {
+		n++
+	}
+
+	v, err := strconv.ParseInt(p.rest[:n], 10, 64)
+	if err != nil {
+		return 0, p.specError(spec, "")
+	}
+	p.rest = p.rest[n:]
+
+	return v, nil
+}
+
+// name consumes the longest of the provided names found at the start of the remaining value, comparing them under
+// Unicode case folding, and returns its index. Empty names, such as the AM/PM designations of locales using the
+// 24-hour clock, never match.
+func (p *parser) name(spec rune, full, short []string) (int, error) {
+	index, length := -1, -1
+	for _, names := range [][]string{full, short} {
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			if n := matchFold(p.rest, name); n > length {
+				index, length = i, n
+			}
+		}
+	}
+
+	if index < 0 {
+		return 0, p.specError(spec, "")
+	}
+	p.rest = p.rest[length:]
+
+	return index, nil
+}
+
+// offset consumes a numeric time zone offset in the forms Z, +hh, +hhmm, +hh:mm and +hh:mm:ss.
+func (p *parser) offset(spec rune) error {
+	if p.rest != "" && p.rest[0] == 'Z' {
+		p.rest = p.rest[1:]
+		p.utc = true
+		return nil
+	}
+
+	value := p.rest
+	if len(value) < 3 || (value[0] != '+' && value[0] != '-') || !isDigit(value[1]) || !isDigit(value[2]) {
+		return p.specError(spec, "")
+	}
+
+	hours, _ := strconv.Atoi(value[1:3])
+	minutes, n := 0, 3
+	if len(value) >= 6 && value[3] == ':' && isDigit(value[4]) && isDigit(value[5]) {
+		minutes, _ = strconv.Atoi(value[4:6])
+		n = 6
+	} else if len(value) >= 5 && isDigit(value[3]) && isDigit(value[4]) {
+		minutes, _ = strconv.Atoi(value[3:5])
+		n = 5
+	}
+	seconds := 0
+	if n == 6 && len(value) >= 9 && value[6] == ':' && isDigit(value[7]) && isDigit(value[8]) {
+		seconds, _ = strconv.Atoi(value[7:9])
+		n = 9
+	}
+	if hours > 24 || minutes > 59 || seconds > 59 {
+		return p.specError(spec, ": time zone offset out of range")
+	}
+
+	p.zoneOffset = (hours*60+minutes)*60 + seconds
+	if value[0] == '-' {
+		p.zoneOffset = -p.zoneOffset
+	}
+	p.rest = value[n:]
+
+	return nil
+}
+
+// zone consumes a time zone abbreviation such as UTC, PST or GMT+3.
+func (p *parser) zone(spec rune) error {
+	n := 0
+	for n < len(p.rest) && p.rest[n] >= 'A' && p.rest[n] <= 'Z' {
+		n++
+	}
+	if n < 3 {
+		return p.specError(spec, "")
+	}
+
+	name := p.rest[:n]
+	if name == "UTC" {
+		p.rest = p.rest[n:]
+		p.utc = true
+		return nil
+	}
+
+	if name == "GMT" && n < len(p.rest) && (p.rest[n] == '+' || p.rest[n] == '-') {
+		m := n + 1
+		for m < len(p.rest) && m < n+3 && isDigit(p.rest[m]) {
+			m++
+		}
+		if hours, err := strconv.Atoi(p.rest[n+1 : m]); err == nil && hours <= 14 {
+			p.zoneOffset = hours * 60 * 60
+			if p.rest[n] == '-' {
+				p.zoneOffset = -p.zoneOffset
+			}
+			n = m
+		}
+	}
+
+	p.zoneName = p.rest[:n]
+	p.rest = p.rest[n:]
+
+	return nil
+}
+
+func (p *parser) skipSpace() {
+	for p.rest != "" && isSpace(p.rest[0]) {
+		p.rest = p.rest[1:]
+	}
+}
+
+// time resolves the parsed fields into a time.Time. Zone handling follows time.Parse.
+func (p *parser) time() (time.Time, error) {
+	if p.hasUnix {
+		t := time.Unix(p.unix, int64(p.nsec))
+		if p.zoneOffset != -1 {
+			return t.In(time.FixedZone(p.zoneName, p.zoneOffset)), nil
+		}
+		if p.loc != nil {
+			return t.In(p.loc), nil
+		}
+		return t.UTC(), nil
+	}
+
+	year, month, day, err := p.date()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	hour := p.hour
+	if p.pm == 1 && hour < 12 {
+		hour += 12
+	} else if p.pm == 0 && hour == 12 {
+		hour = 0
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, p.minute, p.second, p.nsec, time.UTC)
+
+	local := time.Local
+	if p.loc != nil {
+		local = p.loc
+	}
+	switch {
+	case p.utc:
+		return t, nil
+	case p.zoneOffset != -1:
+		t = t.Add(-time.Duration(p.zoneOffset) * time.Second)
+		if name, offset := t.In(local).Zone(); offset == p.zoneOffset && (p.zoneName == "" || name == p.zoneName) {
+			return t.In(local), nil
+		}
+		return t.In(time.FixedZone(p.zoneName, p.zoneOffset)), nil
+	case p.zoneName != "":
+		if offset, found := lookupZoneName(p.zoneName, t, local); found {
+			return t.Add(-time.Duration(offset) * time.Second).In(local), nil
+		}
+		return t.In(time.FixedZone(p.zoneName, 0)), nil
+	case p.loc != nil:
+		return time.Date(year, time.Month(month), day, hour, p.minute, p.second, p.nsec, p.loc), nil
+	}
+
+	return t, nil
+}
+
+// date resolves the year, month and day from the parsed fields and checks that a date given in an era lies within
+// that era. Without a day the era only needs to contain the year.
+func (p *parser) date() (year, month, day int, err error) {
+	year, month, day, err = p.resolveDate()
+	if err != nil || p.eraYear == -1 {
+		return year, month, day, err
+	}
+
+	hasDay := p.month != 0 || p.day != 0 || p.yearDay != 0 || p.isoWeek != -1 || p.week != -1 ||
+		p.sundayWeek != -1 || p.mondayWeek != -1
+	if hasDay && !p.eraOrDefault().contains(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)) {
+		return 0, 0, 0, p.error("", ": date out of era range")
+	}
+
+	return year, month, day, nil
+}
+
+func (p *parser) eraOrDefault() *Era {
+	if p.era != nil {
+		return p.era
+	}
+
+	return &p.l.Eras[0]
+}
+
+// resolveDate resolves the year, month and day from the parsed fields. A date given by month and day takes precedence
+// over one given by the day of the year, which takes precedence over one given by week number and weekday.
+func (p *parser) resolveDate() (year, month, day int, err error) {
+	switch {
+	case p.eraYear != -1:
+		era := p.eraOrDefault()
+		year = era.gregorianYear(p.eraYear)
+		if !era.containsYear(year) {
+			return 0, 0, 0, p.error("", ": era year out of range")
+		}
+	case p.year != -1:
+		year = p.year
+	case p.yearInCentury != -1 && p.rr:
+		year = rrYear(p.yearInCentury, now().Year())
+	case p.yearInCentury != -1 && p.century != -1:
+		year = p.century*100 + p.yearInCentury
+	case p.yearInCentury != -1 && p.yearInCentury >= 69:
+		year = 1900 + p.yearInCentury
+	case p.yearInCentury != -1:
+		year = 2000 + p.yearInCentury
+	case p.century != -1:
+		year = p.century * 100
+	}
+
+	weekday := p.weekday
+	if p.isoWeekday != -1 {
+		weekday = p.isoWeekday % 7
+	}
+	if p.localeWeekday != -1 {
+		weekday = (int(p.l.FirstWeekday) + p.localeWeekday - 1) % 7
+	}
+
+	switch {
+	case p.month != 0 || p.day != 0:
+		month, day = p.month, p.day
+		if month == 0 {
+			month = 1
+		}
+		if day == 0 {
+			day = 1
+		}
+		if day > daysIn(time.Month(month), year) {
+			return 0, 0, 0, p.error("", ": day out of range")
+		}
+		return year, month, day, nil
+	case p.yearDay != 0:
+		if p.yearDay > time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return 0, 0, 0, p.error("", ": day-of-year out of range")
+		}
+		return splitDate(time.Date(year, time.January, p.yearDay, 0, 0, 0, 0, time.UTC))
+	case p.isoWeek != -1 && (p.isoYear != -1 || p.isoYearInCentury != -1):
+		isoYear := p.isoYear
+		if isoYear == -1 {
+			isoYear = 2000 + p.isoYearInCentury
+			if p.isoYearInCentury >= 69 {
+				isoYear = 1900 + p.isoYearInCentury
+			}
+		}
+		if weekday == -1 {
+			weekday = int(time.Monday)
+		}
+		// January 4th is always in the first ISO week.
+		jan4 := time.Date(isoYear, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+		return splitDate(monday.AddDate(0, 0, (p.isoWeek-1)*7+(weekday+6)%7))
+	case p.week != -1:
+		// Without a week based year the year is taken to be one, as the week may start in the previous year.
+		if p.weekYear != -1 {
+			year = p.weekYear
+		}
+		first := p.weekFirst
+		if weekday == -1 {
+			weekday = int(first)
+		}
+		start := weekOneStart(year, first, p.weekMinDays)
+		return splitDate(start.AddDate(0, 0, (p.week-1)*7+(weekday-int(first)+7)%7))
+	case p.sundayWeek != -1 || p.mondayWeek != -1:
+		start, week := time.Sunday, p.sundayWeek
+		if week == -1 {
+			start, week = time.Monday, p.mondayWeek
+		}
+		if weekday == -1 {
+			weekday = int(start)
+		}
+		// Week 1 starts on the first start day of the year, the days before it are in week 0.
+		jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		first := jan1.AddDate(0, 0, (int(start)-int(jan1.Weekday())+7)%7)
+		return splitDate(first.AddDate(0, 0, (week-1)*7+(weekday-int(start)+7)%7))
+	}
+
+	return year, 1, 1, nil
+}
+
+func (p *parser) error(layoutElem, message string) error {
+	return &time.ParseError{Layout: p.format, Value: p.value, LayoutElem: layoutElem, ValueElem: p.rest, Message: message}
+}
+
+func (p *parser) specError(spec rune, message string) error {
+	return p.error(item{spec: spec}.String(), message)
+}
+
+func specRangeName(spec rune) string {
+	switch spec {
+	case 'd', 'e':
+		return "day"
+	case 'm':
+		return "month"
+	case 'H', 'k', 'I', 'l':
+		return "hour"
+	case 'M':
+		return "minute"
+	case 'S':
+		return "second"
+	case 'j':
+		return "day-of-year"
+	case 'u', 'w':
+		return "weekday"
+	case 'U', 'V', 'W', 'K', specMondayWeek, specSundayWeek:
+		return "week"
+	}
+
+	return "year"
+}
+
+// rrYear returns the year of a two digit year read with the Oracle RR format element: the year closest to the current
+// year with the two digits in the window of 50 years before and after the current century's midpoint.
+func rrYear(yy, current int) int {
+	century := current / 100 * 100
+	switch {
+	case current%100 < 50 && yy >= 50:
+		return century - 100 + yy
+	case current%100 >= 50 && yy < 50:
+		return century + 100 + yy
+	}
+
+	return century + yy
+}
+
+func splitDate(t time.Time) (year, month, day int, err error) {
+	return t.Year(), int(t.Month()), t.Day(), nil
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// lookupZoneName looks for a zone of loc with the abbreviation name that is in effect around t and returns its
+// offset.
+func lookupZoneName(name string, t time.Time, loc *time.Location) (int, bool) {
+	for _, probe := range []time.Time{t, t.AddDate(0, -6, 0), t.AddDate(0, 6, 0)} {
+		if zoneName, offset := probe.In(loc).Zone(); zoneName == name {
+			return offset, true
+		}
+	}
+
+	return 0, false
+}
+
+// matchFold returns the length in bytes of the prefix of s that equals name under simple Unicode case folding, or -1
+// if s does not start with name.
+func matchFold(s, name string) int {
+	n := 0
+	for _, want := range name {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if size == 0 || !equalFoldRune(r, want) {
+			return -1
+		}
+		n += size
+	}
+
+	return n
+}
+
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
 }
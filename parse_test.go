@@ -10,6 +10,7 @@ func TestParse(t *testing.T) {
 	type args struct {
 		format     string
 		timeString string
+		opts       []Option
 	}
 	tests := []struct {
 		name    string
@@ -71,6 +72,314 @@ func TestParse(t *testing.T) {
 			want:    timeMustParse(time.RFC1123Z, "Thu, 04 Feb 2010 21:00:57 -0800"),
 			wantErr: false,
 		},
+		{
+			name: "Case-insensitive names",
+			args: args{
+				format:     "%a %b %d %H:%M:%S %Y",
+				timeString: "wED feb 04 21:00:57 2009",
+			},
+			want:    time.Date(2009, time.February, 4, 21, 0, 57, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Full name for an abbreviated name specification",
+			args: args{
+				format:     "%a, %d %b %Y",
+				timeString: "Wednesday, 04 February 2009",
+			},
+			want:    time.Date(2009, time.February, 4, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Abbreviated name for a full name specification",
+			args: args{
+				format:     "%A %B %d %Y",
+				timeString: "wed FEB 04 2009",
+			},
+			want:    time.Date(2009, time.February, 4, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Localized names with non-ASCII case folding",
+			args: args{
+				format:     "%d %B %Y",
+				timeString: "11 MÄRZ 2019",
+				opts:       []Option{WithLocale(DeDE)},
+			},
+			want:    time.Date(2019, time.March, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Localized abbreviated names",
+			args: args{
+				format:     "%a %d %b %Y",
+				timeString: "SAM. 11 FÉVR. 2019",
+				opts:       []Option{WithLocale(FrFR)},
+			},
+			want:    time.Date(2019, time.February, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Localized preferred date representation",
+			args: args{
+				format:     "%x",
+				timeString: "11.05.2019",
+				opts:       []Option{WithLocale(DeDE)},
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Case-insensitive AM/PM designation",
+			args: args{
+				format:     "%I:%M %p",
+				timeString: "11:45 pm",
+			},
+			want:    time.Date(0, time.January, 1, 23, 45, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "AM/PM designation of a locale without one",
+			args: args{
+				format:     "%H:%M%p",
+				timeString: "11:45",
+				opts:       []Option{WithLocale(DeDE)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Day of the year",
+			args: args{
+				format:     "%Y %j",
+				timeString: "2019 131",
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Unknown month name",
+			args: args{
+				format:     "%d %B %Y",
+				timeString: "11 Mai 2019",
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Day out of range",
+			args: args{
+				format:     "%Y-%m-%d",
+				timeString: "2019-02-30",
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Extra text",
+			args: args{
+				format:     "%Y-%m-%d",
+				timeString: "2019-02-28 00:00",
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Japanese era date with the first year name",
+			args: args{
+				format:     "%Ex",
+				timeString: "令和元年5月11日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Japanese era date",
+			args: args{
+				format:     "%Ex",
+				timeString: "平成31年4月30日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Japanese era name and year",
+			args: args{
+				format:     "%EC%Ey年%m月%d日",
+				timeString: "昭和64年01月07日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Date(1989, time.January, 7, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Japanese era date and time",
+			args: args{
+				format:     "%Ec",
+				timeString: "明治45年7月29日 12時00分00秒",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Date(1912, time.July, 29, 12, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Japanese era year after the end of the era",
+			args: args{
+				format:     "%EY",
+				timeString: "平成40年",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Japanese era date after the end of the era",
+			args: args{
+				format:     "%Ex",
+				timeString: "平成31年5月11日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Japanese era name and year after the end of the era",
+			args: args{
+				format:     "%EC%Ey年%m月%d日",
+				timeString: "昭和64年1月10日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Unknown Japanese era",
+			args: args{
+				format:     "%Ex",
+				timeString: "天平元年5月11日",
+				opts:       []Option{WithLocale(JaJP)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name: "Thai Buddhist Era date",
+			args: args{
+				format:     "%Ex",
+				timeString: "11 พ.ค. 2562",
+				opts:       []Option{WithLocale(ThTH)},
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Thai Buddhist Era year",
+			args: args{
+				format:     "%d/%m/%EY",
+				timeString: "11/05/พ.ศ. 2562",
+				opts:       []Option{WithLocale(ThTH)},
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Minguo date",
+			args: args{
+				format:     "%Ex",
+				timeString: "民國108年05月11日",
+				opts:       []Option{WithLocale(ZhTW)},
+			},
+			want:    time.Date(2019, time.May, 11, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "First year of Minguo",
+			args: args{
+				format:     "%EY",
+				timeString: "民國元年",
+				opts:       []Option{WithLocale(ZhTW)},
+			},
+			want:    time.Date(1912, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Before Minguo",
+			args: args{
+				format:     "%EY%m月%d日",
+				timeString: "民前12年06月01日",
+				opts:       []Option{WithLocale(ZhTW)},
+			},
+			want:    time.Date(1900, time.June, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Locale week of year, week starting on Monday",
+			args: args{
+				format:     "%J-W%K-%o",
+				timeString: "2021-W52-6",
+				opts:       []Option{WithLocale(DeDE)},
+			},
+			want:    time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Locale week of year, week starting on Sunday",
+			args: args{
+				format:     "%J-W%K-%o",
+				timeString: "2022-W01-7",
+				opts:       []Option{WithLocale(EnUS)},
+			},
+			want:    time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Fractional seconds and colon separated zone",
+			args: args{
+				format:     "%Y-%m-%dT%H:%M:%S.%N%:z",
+				timeString: "2019-05-11T23:45:24.123-04:00",
+			},
+			want:    time.Date(2019, time.May, 11, 23, 45, 24, 123000000, time.FixedZone("", -4*60*60)),
+			wantErr: false,
+		},
+		{
+			name: "Numeric time zone with seconds",
+			args: args{
+				format:     "%H:%M %::z",
+				timeString: "23:45 +05:30:15",
+			},
+			want:    time.Date(0, time.January, 1, 23, 45, 0, 0, time.FixedZone("", 5*60*60+30*60+15)),
+			wantErr: false,
+		},
+		{
+			name: "Numeric time zone written as Z",
+			args: args{
+				format:     "%H:%M%:z",
+				timeString: "23:45Z",
+			},
+			want:    time.Date(0, time.January, 1, 23, 45, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Unknown conversion as literal text",
+			args: args{
+				format:     "%Y-%m-%d %Q",
+				timeString: "2019-02-28 %Q",
+			},
+			want:    time.Date(2019, time.February, 28, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name: "Unknown conversion in strict mode",
+			args: args{
+				format:     "%Y-%m-%d %Q",
+				timeString: "2019-02-28 %Q",
+				opts:       []Option{WithStrict(true)},
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
 		/*{
 			name:"RFC3339",
 			args:args{
@@ -92,7 +401,7 @@ func TestParse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Parse(tt.args.format, tt.args.timeString)
+			got, err := Parse(tt.args.format, tt.args.timeString, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Convert() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -112,3 +421,14 @@ func timeMustParse(layout, timeString string) time.Time {
 
 	return t
 }
+
+func TestParse_localeWeekRoundTrip(t *testing.T) {
+	for _, l := range []*Locale{EnUS, DeDE, JaJP} {
+		for day := time.Date(2019, time.December, 20, 0, 0, 0, 0, time.UTC); day.Year() < 2023; day = day.AddDate(0, 0, 1) {
+			s := Format("%J-W%K-%o", day, WithLocale(l))
+			if got, err := Parse("%J-W%K-%o", s, WithLocale(l)); err != nil || !got.Equal(day) {
+				t.Fatalf("Parse(%q) with %v = %v, %v, want %v", s, l.Name, got, err, day)
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package strftime
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// A Pattern is a compiled format string, bound to the locale, dialect and location it was compiled with. Formatting
+// and parsing with a Pattern skips the compilation of the format string. A Pattern is safe for concurrent use.
+type Pattern struct {
+	format   string
+	items    []item
+	locale   *Locale
+	dialect  *Dialect
+	location *time.Location
+}
+
+// Compile compiles a format string for formatting and parsing with the locale, dialect and location selected by the
+// options. Format and Parse read unknown conversion specifications as literal text, Compile reports them in a
+// *FormatError.
+func Compile(format string, opts ...Option) (*Pattern, error) {
+	o := newOptions(opts)
+	if o.err != nil {
+		return nil, o.err
+	}
+	p, err := compilePattern(format, o)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// compilePattern compiles a format string with the options. It returns the pattern along with any compile error, so
+// that callers reading unknown conversion specifications as literal text can use it.
+func compilePattern(format string, o options) (*Pattern, error) {
+	items, err := o.dialect.compile(format, o.locale)
+
+	return &Pattern{format: format, items: items, locale: o.locale, dialect: o.dialect, location: o.location}, err
+}
+
+// MustCompile is like Compile but panics if the format string can not be compiled.
+func MustCompile(format string, opts ...Option) *Pattern {
+	p, err := Compile(format, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Format returns t formatted according to the pattern.
+func (p *Pattern) Format(t time.Time) string {
+	return string(p.AppendFormat(make([]byte, 0, len(p.format)*2), t))
+}
+
+// AppendFormat is like Format but appends the formatted time to b and returns the extended buffer.
+func (p *Pattern) AppendFormat(b []byte, t time.Time) []byte {
+	if p.location != nil {
+		t = t.In(p.location)
+	}
+
+	return appendFormat(b, p.items, t, p.locale)
+}
+
+// Parse parses a value formatted according to the pattern and returns the time.Time value it represents.
+func (p *Pattern) Parse(value string) (time.Time, error) {
+	return parseItems(p.format, value, p.items, p.locale, p.location)
+}
+
+// String returns the format string the pattern was compiled from.
+func (p *Pattern) String() string {
+	return p.format
+}
+
+// Dialect returns the dialect of the pattern's format string.
+func (p *Pattern) Dialect() *Dialect {
+	return p.dialect
+}
+
+// Locale returns the locale the pattern was compiled with.
+func (p *Pattern) Locale() *Locale {
+	return p.locale
+}
+
+// A Layout is a format string in configuration, such as a JSON or YAML file. It implements the
+// encoding.TextUnmarshaler interface, compiling the format when the configuration is loaded and reporting unknown
+// conversion specifications in a *FormatError, which holds their offset. The zero Layout is the empty format, compiled
+// with the default options; NewLayout selects the options, such as the dialect and locale, of an unmarshaled format.
+type Layout struct {
+	p    *Pattern
+	opts []Option
+}
+
+// emptyPattern is the pattern of a Layout that has not been unmarshaled.
+var emptyPattern = MustCompile("")
+
+// NewLayout returns an empty Layout that compiles the format it is unmarshaled from with the options, as in
+//
+//	config := Config{Timestamp: strftime.NewLayout(strftime.WithDialect(strftime.DialectICU))}
+//	err := json.Unmarshal(data, &config)
+func NewLayout(opts ...Option) Layout {
+	return Layout{opts: opts}
+}
+
+// Pattern returns the compiled format, a pattern of the empty format for a Layout that has not been unmarshaled.
+func (l Layout) Pattern() *Pattern {
+	if l.p == nil {
+		return emptyPattern
+	}
+
+	return l.p
+}
+
+// String returns the format string.
+func (l Layout) String() string {
+	if l.p == nil {
+		return ""
+	}
+
+	return l.p.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (l Layout) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (l *Layout) UnmarshalText(text []byte) error {
+	p, err := Compile(string(text), l.opts...)
+	if err != nil {
+		return err
+	}
+	l.p = p
+
+	return nil
+}
+
+// Patterns is a list of alternative patterns for values written in one of several formats.
+type Patterns []*Pattern
+
+// Format returns t formatted according to the first pattern.
+func (ps Patterns) Format(t time.Time) string {
+	if len(ps) == 0 {
+		return ""
+	}
+
+	return ps[0].Format(t)
+}
+
+// Parse parses a value with the first pattern that matches it. If none does, it returns the error of the pattern
+// that matched the longest part of the value.
+func (ps Patterns) Parse(value string) (time.Time, error) {
+	var parseErr error
+	rest := len(value) + 1
+	for _, p := range ps {
+		t, err := p.Parse(value)
+		if err == nil {
+			return t, nil
+		}
+		var perr *time.ParseError
+		if errors.As(err, &perr) && len(perr.ValueElem) < rest {
+			parseErr, rest = err, len(perr.ValueElem)
+		} else if parseErr == nil {
+			parseErr = err
+		}
+	}
+	if parseErr == nil {
+		parseErr = errors.New("strftime: no patterns to parse " + strconv.Quote(value))
+	}
+
+	return time.Time{}, parseErr
+}
+
+// cachedFormat formats times with a pattern, reusing the result for consecutive times that are equal at the
+// resolution of the pattern. It only remembers the last time formatted. It is safe for concurrent use.
+type cachedFormat struct {
+	p          *Pattern
+	resolution time.Duration
+	last       atomic.Pointer[formattedTime]
+}
+
+type formattedTime struct {
+	t time.Time
+	s string
+}
+
+func newCachedFormat(p *Pattern) *cachedFormat {
+	resolution := time.Second
+	for _, it := range p.items {
+		switch it.spec {
+		case 'N':
+			digits := it.width
+			if digits == 0 {
+				digits = 9
+			}
+			resolution = min(resolution, time.Duration(pow10(9-digits)))
+		case specUnixMilli:
+			resolution = min(resolution, time.Millisecond)
+		}
+	}
+
+	return &cachedFormat{p: p, resolution: resolution}
+}
+
+func (f *cachedFormat) format(t time.Time) string {
+	t = t.Truncate(f.resolution)
+	if last := f.last.Load(); last != nil && last.t.Equal(t) && last.t.Location() == t.Location() {
+		return last.s
+	}
+
+	s := f.p.Format(t)
+	f.last.Store(&formattedTime{t: t, s: s})
+
+	return s
+}
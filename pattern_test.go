@@ -0,0 +1,179 @@
+package strftime
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		opts    []Option
+		want    string
+		wantErr *FormatError
+	}{
+		{name: "Valid format", format: "%A %-d %B %Y", opts: []Option{WithLocale(DeDE)}, want: "Mittwoch 3 Februar 2021"},
+		{name: "Dialect", format: "%W %D %M %Y", opts: []Option{WithDialect(DialectMySQL)}, want: "Wednesday 3rd February 2021"},
+		{name: "Unknown conversion", format: "%Y %q", wantErr: &FormatError{Format: "%Y %q", Offset: 3, Elem: "%q"}},
+		{name: "Unknown conversion with flags", format: "%-d %_Q", wantErr: &FormatError{Format: "%-d %_Q", Offset: 4, Elem: "%_Q"}},
+		{name: "Conversion outside the dialect", format: "%F %P", opts: []Option{WithDialect(DialectC99)}, wantErr: &FormatError{Format: "%F %P", Offset: 3, Elem: "%P"}},
+		{name: "Trailing percent sign", format: "%d%", wantErr: &FormatError{Format: "%d%", Offset: 2, Elem: "%"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Compile(tt.format, tt.opts...)
+			if tt.wantErr != nil {
+				var ferr *FormatError
+				if !errors.As(err, &ferr) || !reflect.DeepEqual(ferr, tt.wantErr) {
+					t.Errorf("Compile() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+
+			tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+			if got := p.Format(tm); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			if got, err := p.Parse(tt.want); err != nil || got.Year() != 2021 || got.Month() != time.February {
+				t.Errorf("Parse() = %v, %v", got, err)
+			}
+			if p.String() != tt.format {
+				t.Errorf("String() = %q, want %q", p.String(), tt.format)
+			}
+		})
+	}
+}
+
+func TestMustCompile(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustCompile() did not panic")
+		}
+	}()
+	MustCompile("%Q")
+}
+
+func TestLayout_UnmarshalText(t *testing.T) {
+	var config struct {
+		Timestamp Layout `json:"timestamp"`
+	}
+	if err := json.Unmarshal([]byte(`{"timestamp":"%d/%m/%Y %H:%M"}`), &config); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	tm := time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC)
+	if got := config.Timestamp.Pattern().Format(tm); got != "03/02/2021 04:05" {
+		t.Errorf("Pattern().Format() = %q, want %q", got, "03/02/2021 04:05")
+	}
+	if got, err := json.Marshal(config); err != nil || string(got) != `{"timestamp":"%d/%m/%Y %H:%M"}` {
+		t.Errorf("json.Marshal() = %s, %v", got, err)
+	}
+
+	err := json.Unmarshal([]byte(`{"timestamp":"%Y-%m-%d %H:%i"}`), &config)
+	want := &FormatError{Format: "%Y-%m-%d %H:%i", Offset: 12, Elem: "%i"}
+	var ferr *FormatError
+	if !errors.As(err, &ferr) || !reflect.DeepEqual(ferr, want) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, want)
+	}
+	if config.Timestamp.String() != "%d/%m/%Y %H:%M" {
+		t.Errorf("failed UnmarshalText() changed the layout to %q", config.Timestamp.String())
+	}
+
+	var zero Layout
+	if zero.Pattern().Format(tm) != "" || zero.String() != "" {
+		t.Errorf("zero Layout = %q, %q", zero.Pattern().Format(tm), zero.String())
+	}
+	if _, err := zero.Pattern().Parse(""); err != nil {
+		t.Errorf("zero Layout Pattern().Parse() error = %v", err)
+	}
+}
+
+func TestNewLayout(t *testing.T) {
+	config := struct {
+		Timestamp Layout `json:"timestamp"`
+	}{Timestamp: NewLayout(WithDialect(DialectICU), WithLocale(DeDE))}
+	if err := json.Unmarshal([]byte(`{"timestamp":"EEEE, d. MMMM yyyy"}`), &config); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	tm := time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC)
+	if got, want := config.Timestamp.Pattern().Format(tm), "Mittwoch, 3. Februar 2021"; got != want {
+		t.Errorf("Pattern().Format() = %q, want %q", got, want)
+	}
+
+	var cerr *ConversionError
+	if err := json.Unmarshal([]byte(`{"timestamp":"yyyy QQ"}`), &config); !errors.As(err, &cerr) {
+		t.Errorf("json.Unmarshal() with an invalid pattern error = %v, want a *ConversionError", err)
+	}
+}
+
+func TestPatterns_Parse(t *testing.T) {
+	ps := Patterns{MustCompile("%F %T"), MustCompile("%F"), MustCompile("%d/%m/%Y")}
+	tests := []struct {
+		name     string
+		value    string
+		want     time.Time
+		wantElem string
+	}{
+		{name: "First pattern", value: "2021-02-03 04:05:06", want: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)},
+		{name: "Second pattern", value: "2021-02-03", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Third pattern", value: "03/02/2021", want: time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "Error of the longest match", value: "2021-02-03 04:05", wantElem: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ps.Parse(tt.value)
+			if tt.want.IsZero() {
+				var perr *time.ParseError
+				if !errors.As(err, &perr) || perr.ValueElem != tt.wantElem {
+					t.Errorf("Parse() error = %v, want value element %q", err, tt.wantElem)
+				}
+				return
+			}
+			if err != nil || !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := (Patterns{}).Parse("2021"); err == nil {
+		t.Errorf("Parse() without patterns error = nil")
+	}
+}
+
+func Test_cachedFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   time.Duration
+	}{
+		{format: "%F %T", want: time.Second},
+		{format: "%T.%3N", want: time.Millisecond},
+		{format: "%T.%N", want: time.Nanosecond},
+		{format: "%s %6N", want: time.Microsecond},
+	}
+	for _, tt := range tests {
+		if got := newCachedFormat(MustCompile(tt.format)).resolution; got != tt.want {
+			t.Errorf("newCachedFormat(%q) resolution = %v, want %v", tt.format, got, tt.want)
+		}
+	}
+
+	f := newCachedFormat(MustCompile("%T.%3N"))
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 789123456, time.UTC)
+	if got := f.format(tm); got != "04:05:06.789" {
+		t.Errorf("format() = %q, want %q", got, "04:05:06.789")
+	}
+	if got := f.format(tm.Add(100 * time.Microsecond)); got != "04:05:06.789" {
+		t.Errorf("format() within the resolution = %q, want %q", got, "04:05:06.789")
+	}
+	if got := f.format(tm.Add(time.Millisecond)); got != "04:05:06.790" {
+		t.Errorf("format() = %q, want %q", got, "04:05:06.790")
+	}
+	if got := f.format(tm.Add(time.Millisecond).In(time.FixedZone("", 3600))); got != "05:05:06.790" {
+		t.Errorf("format() in another location = %q, want %q", got, "05:05:06.790")
+	}
+}
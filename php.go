@@ -0,0 +1,81 @@
+package strftime
+
+import "strings"
+
+// DialectPHP is the format language of the PHP date and DateTime::createFromFormat functions, as in
+// D, d M Y H:i:s. A backslash escapes the character following it and characters that are not format characters are
+// copied. The format characters z (day of the year from 0), t, L, B, e, I, p, Z, X and x have no strftime
+// equivalent and are reported as errors.
+var DialectPHP = &Dialect{
+	name:    "PHP",
+	target:  "a PHP date format",
+	compile: compilePHP,
+	render:  renderPHP,
+}
+
+// phpSpecs holds the compiled equivalents of the PHP format characters.
+var phpSpecs = func() map[string][]item {
+	specs := specTable(map[string]string{
+		"d": "%d", "D": "%a", "j": "%-d", "l": "%A", "N": "%u", "w": "%w",
+		"W": "%0V", "F": "%B", "m": "%m", "M": "%b", "n": "%-m", "o": "%G", "Y": "%Y", "y": "%y",
+		"a": "%P", "A": "%p", "g": "%-I", "G": "%-H", "h": "%I", "H": "%H", "i": "%M", "s": "%S", "u": "%6N", "v": "%3N",
+		"O": "%z", "P": "%:z", "T": "%Z", "U": "%s",
+		"c": "%Y-%m-%dT%H:%M:%S%:z", "r": "%a, %d %b %Y %H:%M:%S %z",
+	})
+	specs["S"] = []item{{spec: specDaySuffix}}
+
+	return specs
+}()
+
+// phpUnsupported holds the PHP format characters without a strftime equivalent.
+const phpUnsupported = "ztLBeIpZXx"
+
+func compilePHP(f string, _ *Locale) ([]item, error) {
+	cerr := &ConversionError{Format: f, Target: "a strftime format"}
+
+	var items []item
+	for i := 0; i < len(f); i++ {
+		c := f[i : i+1]
+		switch {
+		case c == `\` && i+1 < len(f):
+			i++
+			items = appendText(items, f[i:i+1])
+		case phpSpecs[c] != nil:
+			for _, it := range phpSpecs[c] {
+				if it.spec == 0 {
+					items = appendText(items, it.text)
+					continue
+				}
+				items = append(items, it)
+			}
+		case strings.Contains(phpUnsupported, c):
+			cerr.Specs = append(cerr.Specs, c)
+			items = appendText(items, c)
+		default:
+			items = appendText(items, c)
+		}
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+func renderPHP(items []item, cerr *ConversionError) string {
+	return renderSpecs(items, phpSpecs, quotePHP, cerr)
+}
+
+// quotePHP escapes the ASCII letters and backslashes of literal text with a backslash.
+func quotePHP(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if isASCIILetter(text[i]) || text[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(text[i])
+	}
+
+	return b.String()
+}
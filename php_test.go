@@ -0,0 +1,70 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormat_php(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "RFC 2822 style", format: "D, d M Y H:i:s O", t: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.FixedZone("", -5*60*60)), want: "Wed, 03 Feb 2021 04:05:06 -0500"},
+		{name: "Ordinal day and escaped letters", format: `l \t\h\e jS \of F`, t: time.Date(2021, time.February, 22, 0, 0, 0, 0, time.UTC), want: "Monday the 22nd of February"},
+		{name: "ISO 8601 and RFC 2822 composites", format: "c|r", t: time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC), want: "2021-02-03T04:05:06+00:00|Wed, 03 Feb 2021 04:05:06 +0000"},
+		{name: "Unpadded 12-hour time", format: "g:i a, u", t: time.Date(2021, time.February, 3, 16, 5, 6, 123456789, time.UTC), want: "4:05 pm, 123456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectPHP)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_php(t *testing.T) {
+	got, err := Parse("D, jS M Y H:i:s P", "Mon, 22nd Feb 2021 04:05:06 +01:00", WithDialect(DialectPHP))
+	if want := time.Date(2021, time.February, 22, 3, 5, 6, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestConvert_php(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "PHP to strftime", format: "D, d M Y H:i:s", from: DialectPHP, to: DialectC, want: "%a, %d %b %Y %H:%M:%S"},
+		{name: "PHP escapes to strftime", format: `\Y\e\a\r: Y, \\ 100%`, from: DialectPHP, to: DialectC, want: `Year: %Y, \ 100%%`},
+		{name: "PHP characters without an equivalent", format: "z t L e", from: DialectPHP, to: DialectC, wantSpecs: []string{"z", "t", "L", "e"}},
+		{name: "strftime to PHP", format: "%FT%T%:z", from: DialectC, to: DialectPHP, want: "c"},
+		{name: "strftime literal letters to PHP", format: "%e. %B at %-I:%M %p", from: DialectC, to: DialectPHP, want: `j. F \a\t g:i A`},
+		{name: "strftime specifications without an equivalent", format: "%j %U %N", from: DialectC, to: DialectPHP, wantSpecs: []string{"%j", "%U", "%N"}},
+		{name: "MySQL ordinal day to PHP", format: "%D", from: DialectMySQL, to: DialectPHP, want: "jS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package strftime
+
+import (
+	"sort"
+	"strings"
+)
+
+// DialectPostgreSQL is the template language of the PostgreSQL to_char and to_timestamp functions, as in
+// YYYY-MM-DD"T"HH24:MI:SS.USTZH:TZM. Text in double quotes is literal, with \" standing for a double quote. The FM
+// prefix suppresses the zero padding of numbers, the FX and TM prefixes are accepted and have no effect, and the th
+// suffix of DD adds the English ordinal suffix of the day.
+//
+// Template patterns without a strftime equivalent are reported as errors: the blank padded names of MONTH, Month,
+// DAY and Day without FM, names in all upper or lower case, Y,YYY, YYY, Y, IYY, I, D, IDDD, W, WW, CC, J, Q, RM,
+// SSSS, SSSSS, TZH and TZM on their own, OF, the era indicators and the TH suffix elsewhere. CC counts centuries from
+// one, 21 for 2019, unlike %C. Conversion specifications without a template pattern include %s, %C, %U, %W, %w, %K,
+// %o, %N with more than six digits and space padding.
+var DialectPostgreSQL = &Dialect{
+	name:    "PostgreSQL",
+	target:  "a PostgreSQL template",
+	compile: compilePostgreSQL,
+	render:  renderPostgreSQL,
+}
+
+// postgresPatterns holds the compiled equivalents of the template patterns. Rendering prefers them to the
+// postgresAliases, which are only read.
+var postgresPatterns = map[string][]item{
+	"HH":      {{spec: 'I'}},
+	"HH24":    {{spec: 'H'}},
+	"MI":      {{spec: 'M'}},
+	"SS":      {{spec: 'S'}},
+	"MS":      {{spec: 'N', width: 3}},
+	"US":      {{spec: 'N', width: 6}},
+	"AM":      {{spec: 'p'}},
+	"am":      {{spec: 'P'}},
+	"YYYY":    {{spec: 'Y'}},
+	"YY":      {{spec: 'y'}},
+	"IYYY":    {{spec: 'G', pad: '0'}},
+	"IY":      {{spec: 'g'}},
+	"MM":      {{spec: 'm'}},
+	"FMMonth": {{spec: 'B'}},
+	"Mon":     {{spec: 'b'}},
+	"DD":      {{spec: 'd'}},
+	"DDth":    {{spec: 'd'}, {spec: specDaySuffix}},
+	"DDD":     {{spec: 'j'}},
+	"FMDay":   {{spec: 'A'}},
+	"Dy":      {{spec: 'a'}},
+	"ID":      {{spec: 'u'}},
+	"IW":      {{spec: 'V', pad: '0'}},
+	"TZ":      {{spec: 'Z'}},
+	"TZH:TZM": {{spec: 'z', colons: 1}},
+	"TZHTZM":  {{spec: 'z'}},
+}
+
+var postgresAliases = map[string][]item{
+	"HH12": {{spec: 'I'}},
+	"PM":   {{spec: 'p'}},
+	"pm":   {{spec: 'P'}},
+	"FF1":  {{spec: 'N', width: 1}},
+	"FF2":  {{spec: 'N', width: 2}},
+	"FF3":  {{spec: 'N', width: 3}},
+	"FF4":  {{spec: 'N', width: 4}},
+	"FF5":  {{spec: 'N', width: 5}},
+	"FF6":  {{spec: 'N', width: 6}},
+}
+
+// postgresKeywords holds the template patterns recognized in a template, longest first. The patterns of names, the
+// meridiem and era indicators, RM and TZ are matched with regard to case, the others without.
+var postgresKeywords = func() []string {
+	keywords := []string{
+		"TZH:TZM", "TZHTZM",
+		"HH24", "HH12", "HH", "MI", "SSSSS", "SSSS", "SS", "MS", "US", "FF1", "FF2", "FF3", "FF4", "FF5", "FF6",
+		"AM", "am", "PM", "pm", "A.M.", "a.m.", "P.M.", "p.m.",
+		"Y,YYY", "YYYY", "YYY", "YY", "Y", "IYYY", "IYY", "IY", "I",
+		"BC", "bc", "AD", "ad", "B.C.", "b.c.", "A.D.", "a.d.",
+		"MONTH", "Month", "month", "MON", "Mon", "mon", "MM",
+		"DAY", "Day", "day", "DY", "Dy", "dy", "DDD", "DD", "D", "IDDD", "ID",
+		"W", "WW", "IW", "CC", "J", "Q", "RM", "rm", "TZH", "TZM", "TZ", "tz", "OF",
+	}
+	sort.SliceStable(keywords, func(i, j int) bool { return len(keywords[i]) > len(keywords[j]) })
+
+	return keywords
+}()
+
+// postgresCaseSensitive holds every spelling of the template patterns matched with regard to case, as the case of
+// the pattern selects the case of the output.
+var postgresCaseSensitive = map[string]bool{
+	"AM": true, "am": true, "PM": true, "pm": true, "A.M.": true, "a.m.": true, "P.M.": true, "p.m.": true,
+	"BC": true, "bc": true, "AD": true, "ad": true, "B.C.": true, "b.c.": true, "A.D.": true, "a.d.": true,
+	"MONTH": true, "Month": true, "month": true, "MON": true, "Mon": true, "mon": true,
+	"DAY": true, "Day": true, "day": true, "DY": true, "Dy": true, "dy": true,
+	"RM": true, "rm": true, "TZ": true, "tz": true,
+}
+
+func compilePostgreSQL(f string, _ *Locale) ([]item, error) {
+	cerr := &ConversionError{Format: f, Target: "a strftime format"}
+
+	var items []item
+	for i := 0; i < len(f); {
+		switch {
+		case f[i] == '"':
+			text, n := unquotePostgreSQL(f[i:])
+			items = appendText(items, text)
+			i += n
+			continue
+		case strings.HasPrefix(f[i:], `\"`):
+			items = appendText(items, `"`)
+			i += 2
+			continue
+		}
+
+		fm, n := false, 0
+		for i+n+2 <= len(f) {
+			prefix := strings.ToUpper(f[i+n : i+n+2])
+			if prefix != "FM" && prefix != "FX" && prefix != "TM" {
+				break
+			}
+			fm = fm || prefix == "FM"
+			n += 2
+		}
+		keyword := postgresKeyword(f[i+n:])
+		if keyword == "" {
+			// Prefixes without a template pattern and other characters are copied.
+			if i+n == len(f) {
+				n--
+			}
+			items = appendText(items, f[i:i+n+1])
+			i += n + 1
+			continue
+		}
+		token := f[i : i+n+len(keyword)]
+		i += n + len(keyword)
+
+		spec := postgresSpec(keyword, fm)
+		if strings.HasPrefix(f[i:], "th") || strings.HasPrefix(f[i:], "TH") {
+			token += f[i : i+2]
+			if f[i] == 't' && keyword == "DD" {
+				spec = append(spec[:len(spec):len(spec)], item{spec: specDaySuffix})
+			} else {
+				spec = nil
+			}
+			i += 2
+		}
+		if spec == nil {
+			cerr.Specs = append(cerr.Specs, token)
+			items = appendText(items, token)
+			continue
+		}
+		items = append(items, spec...)
+	}
+
+	if !cerr.empty() {
+		return items, cerr
+	}
+
+	return items, nil
+}
+
+// postgresKeyword returns the template pattern at the start of s, or "" if there is none.
+func postgresKeyword(s string) string {
+	for _, keyword := range postgresKeywords {
+		if len(s) < len(keyword) {
+			continue
+		}
+		if s[:len(keyword)] == keyword || !postgresCaseSensitive[keyword] && strings.EqualFold(s[:len(keyword)], keyword) {
+			return keyword
+		}
+	}
+
+	return ""
+}
+
+// postgresSpec returns the compiled equivalent of a template pattern with or without the FM prefix, nil if there
+// is none.
+func postgresSpec(keyword string, fm bool) []item {
+	if fm {
+		if spec, found := postgresPatterns["FM"+keyword]; found {
+			return spec
+		}
+	}
+
+	spec, found := postgresPatterns[keyword]
+	if !found {
+		spec, found = postgresAliases[keyword]
+	}
+	if upper := strings.ToUpper(keyword); !found && !postgresCaseSensitive[upper] {
+		if spec, found = postgresPatterns[upper]; !found {
+			spec = postgresAliases[upper]
+		}
+	}
+	if !found || !fm {
+		return spec
+	}
+
+	if _, number := numberSpecs[spec[0].spec]; number {
+		spec = append([]item(nil), spec...)
+		spec[0].pad = '-'
+	}
+
+	return spec
+}
+
+func renderPostgreSQL(items []item, cerr *ConversionError) string {
+	var b strings.Builder
+	for len(items) > 0 {
+		if items[0].spec == 0 {
+			b.WriteString(quotePostgreSQL(items[0].text))
+			items = items[1:]
+			continue
+		}
+
+		key, n := matchSpecs(items, postgresPatterns)
+		if n == 0 {
+			// Unpadded numbers are the zero padded patterns in fill mode.
+			it := canonical(items[0])
+			if def, number := numberSpecs[it.spec]; number && padding(it.pad, def.pad) == '-' {
+				it.pad = '0'
+				fill := append([]item{it}, items[1:]...)
+				if key, n = matchSpecs(fill, postgresPatterns); n > 0 {
+					key = "FM" + key
+				}
+			}
+		}
+		if n == 0 {
+			cerr.Specs = append(cerr.Specs, items[0].String())
+			items = items[1:]
+			continue
+		}
+		b.WriteString(key)
+		items = items[n:]
+	}
+
+	return b.String()
+}
+
+// unquotePostgreSQL reads the quoted text at the start of s, which starts with a double quote, and returns the
+// literal text and the number of bytes read. A backslash escapes the character following it. An unterminated quote
+// extends to the end of s.
+func unquotePostgreSQL(s string) (string, int) {
+	var b strings.Builder
+	i := 1
+	for i < len(s) {
+		switch {
+		case s[i] == '"':
+			return b.String(), i + 1
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+
+	return b.String(), i
+}
+
+// quotePostgreSQL quotes literal text containing ASCII letters, which a template could read as patterns or
+// prefixes. Double quotes are escaped with a backslash.
+func quotePostgreSQL(text string) string {
+	if strings.IndexFunc(text, func(r rune) bool { return r < 0x80 && isASCIILetter(byte(r)) }) < 0 {
+		return strings.ReplaceAll(text, `"`, `\"`)
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
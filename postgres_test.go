@@ -0,0 +1,75 @@
+package strftime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConvert_postgreSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		from, to  *Dialect
+		want      string
+		wantSpecs []string
+	}{
+		{name: "ISO 8601 to strftime", format: `YYYY-MM-DD"T"HH24:MI:SS.USTZH:TZM`, from: DialectPostgreSQL, to: DialectC, want: "%Y-%m-%dT%H:%M:%S.%6N%:z"},
+		{name: "Fill mode and names to strftime", format: "FMDay, FMDDth FMMonth YYYY FMHH12:MI am", from: DialectPostgreSQL, to: DialectC, wantSpecs: []string{"<day of month suffix>"}},
+		{name: "Lower case numeric patterns", format: "yyyy-mm-dd hh24:mi:ss.ms", from: DialectPostgreSQL, to: DialectC, want: "%Y-%m-%d %H:%M:%S.%3N"},
+		{name: "ISO week date", format: "IYYY-\"W\"IW-ID", from: DialectPostgreSQL, to: DialectC, want: "%0G-W%0V-%u"},
+		{name: "Escaped double quote", format: `"at \"noon\"" HH \"`, from: DialectPostgreSQL, to: DialectC, want: `at "noon" %I "`},
+		{name: "Patterns without an equivalent", format: "Month DAY Y,YYY WW CC Q TZH OF DDTH", from: DialectPostgreSQL, to: DialectC, wantSpecs: []string{"Month", "DAY", "Y,YYY", "WW", "CC", "Q", "TZH", "OF", "DDTH"}},
+		{name: "Mixed case names to strftime", format: "Mon Dy FMMonth FMDay", from: DialectPostgreSQL, to: DialectC, want: "%b %a %B %A"},
+		{name: "All upper and lower case names", format: "MON mon dy FMmonth FMday", from: DialectPostgreSQL, to: DialectC, wantSpecs: []string{"MON", "mon", "dy", "FMmonth", "FMday"}},
+		{name: "strftime to template", format: "%Y-%m-%dT%H:%M:%S%z", from: DialectC, to: DialectPostgreSQL, want: `YYYY-MM-DD"T"HH24:MI:SSTZHTZM`},
+		{name: "strftime unpadded fields to fill mode", format: "%e.%-m. %k:%M %P", from: DialectC, to: DialectPostgreSQL, want: "FMDD.FMMM. FMHH24:MI am"},
+		{name: "strftime names and ISO week", format: "%A %B %a %b %G-%V", from: DialectC, to: DialectPostgreSQL, want: "FMDay FMMonth Dy Mon FMIYYY-FMIW"},
+		{name: "strftime literal text", format: `%H o'clock "sharp"`, from: DialectC, to: DialectPostgreSQL, want: `HH24" o'clock \"sharp\""`},
+		{name: "strftime specifications without an equivalent", format: "%s %C %U %w %N %_d", from: DialectC, to: DialectPostgreSQL, wantSpecs: []string{"%s", "%C", "%U", "%w", "%N", "%_d"}},
+		{name: "MySQL ordinal day to template", format: "%D %M", from: DialectMySQL, to: DialectPostgreSQL, want: "FMDDth FMMonth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.format, tt.from, tt.to)
+			if tt.wantSpecs == nil {
+				if err != nil || got != tt.want {
+					t.Errorf("Convert() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+
+			var cerr *ConversionError
+			if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr.Specs, tt.wantSpecs) {
+				t.Errorf("Convert() error = %v, want specs %q", err, tt.wantSpecs)
+			}
+		})
+	}
+}
+
+func TestFormat_postgreSQL(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		t      time.Time
+		want   string
+	}{
+		{name: "Timestamp", format: `YYYY-MM-DD"T"HH24:MI:SS.MSTZH:TZM`, t: time.Date(2021, time.February, 3, 4, 5, 6, 789000000, time.FixedZone("", 5*60*60+30*60)), want: "2021-02-03T04:05:06.789+05:30"},
+		{name: "Fill mode and ordinal day", format: "FMDay, FMDDth FMMonth YYYY FMHH12:MI am", t: time.Date(2021, time.February, 22, 16, 5, 0, 0, time.UTC), want: "Monday, 22nd February 2021 4:05 pm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.format, tt.t, WithDialect(DialectPostgreSQL)); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_postgreSQL(t *testing.T) {
+	got, err := Parse("FMDD Mon YYYY HH24:MI:SS.US", "3 Feb 2021 04:05:06.123456", WithDialect(DialectPostgreSQL))
+	if want := time.Date(2021, time.February, 3, 4, 5, 6, 123456000, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("Parse() = %v, %v, want %v", got, err, want)
+	}
+}
@@ -0,0 +1,102 @@
+package strftime
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// A Printable is a time printed by the fmt package in a strftime format. It implements the fmt.Formatter interface
+// for the verbs %v, %s and %q, honoring the width, the precision, which limits the number of characters, and the
+// - flag for left alignment, as in
+//
+//	fmt.Printf("%-20v|\n", strftime.T(t, "%F %T"))
+//
+// Formats are compiled once and cached, and printing with %v and %s takes no allocations beyond those of the fmt
+// package. %q allocates the string it quotes.
+type Printable struct {
+	t      time.Time
+	format string
+}
+
+// T returns a Printable printing t in format.
+func T(t time.Time, format string) Printable {
+	return Printable{t: t, format: format}
+}
+
+// String returns the formatted time.
+func (p Printable) String() string {
+	return string(p.appendFormat(nil))
+}
+
+// Format implements the fmt.Formatter interface.
+func (p Printable) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'q':
+	default:
+		fmt.Fprintf(f, "%%!%c(strftime.Printable=%s)", verb, p.String())
+		return
+	}
+
+	buf := printBuffers.Get().(*[]byte)
+	defer printBuffers.Put(buf)
+	full := p.appendFormat((*buf)[:0])
+	b := full
+	if prec, ok := f.Precision(); ok {
+		b = truncateRunes(b, prec)
+	}
+	if verb == 'q' {
+		// The quoted string follows the formatted time in the buffer.
+		full = strconv.AppendQuote(b, string(b))
+		b = full[len(b):]
+	}
+	*buf = full
+
+	pad := 0
+	if width, ok := f.Width(); ok {
+		pad = width - utf8.RuneCount(b)
+	}
+	if !f.Flag('-') {
+		writePadding(f, pad)
+	}
+	f.Write(b)
+	if f.Flag('-') {
+		writePadding(f, pad)
+	}
+}
+
+func (p Printable) appendFormat(b []byte) []byte {
+	pattern, err := cachedPattern(p.format)
+	if err != nil {
+		return append(b, Format(p.format, p.t)...)
+	}
+
+	return pattern.AppendFormat(b, p.t)
+}
+
+// printBuffers holds the buffers formatted times are printed from.
+var printBuffers = sync.Pool{New: func() any { b := make([]byte, 0, 64); return &b }}
+
+var spaces = []byte("                                ")
+
+func writePadding(f fmt.State, n int) {
+	for n > 0 {
+		chunk := min(n, len(spaces))
+		f.Write(spaces[:chunk])
+		n -= chunk
+	}
+}
+
+// truncateRunes returns the first n runes of b.
+func truncateRunes(b []byte, n int) []byte {
+	for i := range string(b) {
+		if n == 0 {
+			return b[:i]
+		}
+		n--
+	}
+
+	return b
+}
@@ -0,0 +1,58 @@
+package strftime
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPrintable_Format(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		format string
+		p      Printable
+		want   string
+	}{
+		{format: "%v", p: T(tm, "%F %T"), want: "2021-02-03 04:05:06"},
+		{format: "%s", p: T(tm, "%d.%m."), want: "03.02."},
+		{format: "%q", p: T(tm, "%F"), want: `"2021-02-03"`},
+		{format: "[%8.4q]", p: T(tm, "%F"), want: `[  "2021"]`},
+		{format: "[%12v]", p: T(tm, "%F"), want: "[  2021-02-03]"},
+		{format: "[%-12v]", p: T(tm, "%F"), want: "[2021-02-03  ]"},
+		{format: "[%.4v]", p: T(tm, "%F"), want: "[2021]"},
+		{format: "[%-6.4s]", p: T(tm, "%F"), want: "[2021  ]"},
+		{format: "[%8v]", p: T(tm, "%B"), want: "[February]"},
+		{format: "[%45v]", p: T(tm, "%F"), want: "[                                   2021-02-03]"},
+		{format: "%v", p: T(tm, "%Y %Q"), want: "2021 %Q"},
+		{format: "%d", p: T(tm, "%Y"), want: "%!d(strftime.Printable=2021)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.p); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintable_String(t *testing.T) {
+	p := T(time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC), "%F %T")
+	if got := p.String(); got != "2021-02-03 04:05:06" {
+		t.Errorf("String() = %q, want %q", got, "2021-02-03 04:05:06")
+	}
+}
+
+func TestPrintable_allocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector makes sync.Pool drop buffers")
+	}
+	p := T(time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC), "%F %T")
+	var arg any = p
+	allocs := testing.AllocsPerRun(100, func() {
+		fmt.Fprintf(io.Discard, "%-24v|", arg)
+	})
+	if allocs > 0 {
+		t.Errorf("Fprintf() allocated %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build race
+
+package strftime
+
+// raceEnabled reports whether the tests run with the race detector, which makes sync.Pool drop items at random.
+const raceEnabled = true
@@ -0,0 +1,49 @@
+package strftime
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{}
+)
+
+func init() {
+	for _, l := range []*Locale{EnUS, DeDE, EsES, FrFR, JaJP, ThTH, ZhTW} {
+		locales[l.Name] = l
+	}
+}
+
+// RegisterLocale makes a locale available by name to LookupLocale and the WithLocaleName option, replacing any
+// locale previously registered under the name, including the shipped ones. It is safe to call concurrently with
+// lookups. RegisterLocale panics if l is nil.
+func RegisterLocale(name string, l *Locale) {
+	if l == nil {
+		panic("strftime: RegisterLocale locale is nil")
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[localeKey(name)] = l
+}
+
+// LookupLocale returns the locale registered under name. Names are matched ignoring a trailing code set or modifier
+// and with a hyphen accepted in place of the underscore, so "en-US" and "en_US.UTF-8" both find "en_US".
+func LookupLocale(name string) (*Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	l, found := locales[localeKey(name)]
+
+	return l, found
+}
+
+// localeKey normalizes a POSIX or BCP 47 style locale name.
+func localeKey(name string) string {
+	if i := strings.IndexAny(name, ".@"); i >= 0 {
+		name = name[:i]
+	}
+
+	return strings.ReplaceAll(name, "-", "_")
+}
@@ -0,0 +1,107 @@
+package strftime
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		want   *Locale
+		found  bool
+	}{
+		{
+			name:   "Shipped locale",
+			locale: "ja_JP",
+			want:   JaJP,
+			found:  true,
+		},
+		{
+			name:   "Locale name with a code set",
+			locale: "de_DE.UTF-8",
+			want:   DeDE,
+			found:  true,
+		},
+		{
+			name:   "BCP 47 style locale name",
+			locale: "fr-FR",
+			want:   FrFR,
+			found:  true,
+		},
+		{
+			name:   "Unknown locale",
+			locale: "xx_XX",
+			want:   nil,
+			found:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := LookupLocale(tt.locale)
+			if got != tt.want || found != tt.found {
+				t.Errorf("LookupLocale() = %v, %v, want %v, %v", got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	corporate := *EnUS
+	corporate.Name = "en_US_corp"
+	corporate.ShortMonths[8] = "Sept"
+	registerTestLocale(t, "en-US-corp", &corporate)
+
+	ts := time.Date(2019, time.September, 11, 23, 45, 24, 0, time.UTC)
+	if got, want := Format("%d %b %Y", ts, WithLocaleName("en_US_corp")), "11 Sept 2019"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+
+	got, err := Parse("%d %b %Y", "11 sept 2019", WithLocaleName("en_US_corp"))
+	if err != nil || !got.Equal(time.Date(2019, time.September, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Parse() = %v, %v", got, err)
+	}
+
+	if _, err := Parse("%d %b %Y", "11 Sep 2019", WithLocaleName("en_US_unknown")); err == nil {
+		t.Errorf("Parse() with an unknown locale did not return an error")
+	}
+	if _, err := Compile("%d %b %Y", WithLocaleName("en_US_unknown")); err == nil {
+		t.Errorf("Compile() with an unknown locale did not return an error")
+	}
+	if got, want := Format("%A", ts, WithLocale(DeDE), WithLocaleName("en_US_unknown")), "Mittwoch"; got != want {
+		t.Errorf("Format() with an unknown locale = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterLocale_concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("xx_%d", i)
+			registerTestLocale(t, name, EnUS)
+			for j := 0; j < 100; j++ {
+				if _, found := LookupLocale(name); !found {
+					t.Errorf("LookupLocale(%q) not found", name)
+				}
+				Format("%A", time.Now(), WithLocaleName("en_US"))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// registerTestLocale registers a locale for the duration of a test.
+func registerTestLocale(t *testing.T, name string, l *Locale) {
+	t.Helper()
+	RegisterLocale(name, l)
+	t.Cleanup(func() {
+		localesMu.Lock()
+		defer localesMu.Unlock()
+		delete(locales, localeKey(name))
+	})
+}
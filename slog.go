@@ -0,0 +1,118 @@
+package strftime
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ReplaceAttr returns a function for the ReplaceAttr field of slog.HandlerOptions that formats the record time and
+// all other time.Time attribute values with a pattern, as in
+//
+//	p := strftime.MustCompile("%Y-%m-%d %H:%M:%S.%3N %z")
+//	h := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{ReplaceAttr: strftime.ReplaceAttr(p)})
+//
+// A time equal to the previously formatted one at the resolution of the pattern, a millisecond with %3N, reuses its
+// formatted string, so that records logged in quick succession format their time once.
+func ReplaceAttr(p *Pattern) func(groups []string, a slog.Attr) slog.Attr {
+	f := newCachedFormat(p)
+
+	return func(_ []string, a slog.Attr) slog.Attr {
+		return f.replaceAttr(a)
+	}
+}
+
+// NewSlogHandler returns a handler that formats the record time and all other time.Time attribute values with a
+// pattern before passing the records on to h. The record time is passed on as an attribute under slog.TimeKey
+// following the message, outside any groups opened with WithGroup. As with ReplaceAttr, a time equal to the previously
+// formatted one at the resolution of the pattern reuses its formatted string.
+func NewSlogHandler(h slog.Handler, p *Pattern) slog.Handler {
+	return &slogHandler{h: h, f: newCachedFormat(p)}
+}
+
+// slogHandler passes the attributes added before the first group on to h. The groups and the attributes added to
+// them are kept in goas and added to each record, so that the record time stays outside of them.
+type slogHandler struct {
+	h    slog.Handler
+	f    *cachedFormat
+	goas []groupOrAttrs
+}
+
+// groupOrAttrs is a group opened with WithGroup or the attributes added with WithAttrs.
+type groupOrAttrs struct {
+	group string
+	attrs []slog.Attr
+}
+
+func (h *slogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.h.Enabled(ctx, level)
+}
+
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	formatted := slog.NewRecord(time.Time{}, r.Level, r.Message, r.PC)
+	if !r.Time.IsZero() {
+		formatted.AddAttrs(slog.String(slog.TimeKey, h.f.format(r.Time)))
+	}
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, h.f.replaceAttr(a))
+		return true
+	})
+	for i := len(h.goas) - 1; i >= 0; i-- {
+		if goa := h.goas[i]; goa.group != "" {
+			attrs = []slog.Attr{{Key: goa.group, Value: slog.GroupValue(attrs...)}}
+		} else {
+			attrs = append(goa.attrs[:len(goa.attrs):len(goa.attrs)], attrs...)
+		}
+	}
+	formatted.AddAttrs(attrs...)
+
+	return h.h.Handle(ctx, formatted)
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	replaced := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		replaced[i] = h.f.replaceAttr(a)
+	}
+	if len(h.goas) == 0 {
+		return &slogHandler{h: h.h.WithAttrs(replaced), f: h.f}
+	}
+
+	return h.with(groupOrAttrs{attrs: replaced})
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	return h.with(groupOrAttrs{group: name})
+}
+
+func (h *slogHandler) with(goa groupOrAttrs) *slogHandler {
+	goas := append(h.goas[:len(h.goas):len(h.goas)], goa)
+
+	return &slogHandler{h: h.h, f: h.f, goas: goas}
+}
+
+// replaceAttr formats time values, including those in groups.
+func (f *cachedFormat) replaceAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	switch a.Value.Kind() {
+	case slog.KindTime:
+		a.Value = slog.StringValue(f.format(a.Value.Time()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		replaced := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			replaced[i] = f.replaceAttr(ga)
+		}
+		a.Value = slog.GroupValue(replaced...)
+	}
+
+	return a
+}
@@ -0,0 +1,66 @@
+package strftime
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReplaceAttr(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 789123456, time.UTC)
+	var b strings.Builder
+	h := slog.NewTextHandler(&b, &slog.HandlerOptions{ReplaceAttr: ReplaceAttr(MustCompile("%Y-%m-%d %H:%M:%S.%3N %z"))})
+	r := slog.NewRecord(tm, slog.LevelInfo, "hello", 0)
+	r.AddAttrs(slog.Time("due", tm.Add(time.Hour)), slog.Group("g", slog.Time("at", tm)), slog.Int("n", 1))
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	want := `time="2021-02-03 04:05:06.789 +0000" level=INFO msg=hello due="2021-02-03 05:05:06.789 +0000" g.at="2021-02-03 04:05:06.789 +0000" n=1` + "\n"
+	if b.String() != want {
+		t.Errorf("Handle() wrote %q, want %q", b.String(), want)
+	}
+}
+
+func TestNewSlogHandler(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 789123456, time.UTC)
+	var b strings.Builder
+	h := NewSlogHandler(slog.NewJSONHandler(&b, nil), MustCompile("%F %T"))
+	h = h.WithAttrs([]slog.Attr{slog.Time("started", tm.Add(-time.Hour))})
+	r := slog.NewRecord(tm, slog.LevelWarn, "hello", 0)
+	r.AddAttrs(slog.Any("due", tm.Add(time.Hour)), slog.String("s", "x"))
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	want := `{"level":"WARN","msg":"hello","started":"2021-02-03 03:05:06","time":"2021-02-03 04:05:06","due":"2021-02-03 05:05:06","s":"x"}` + "\n"
+	if b.String() != want {
+		t.Errorf("Handle() wrote %q, want %q", b.String(), want)
+	}
+	if !h.Enabled(context.Background(), slog.LevelWarn) || h.Enabled(context.Background(), slog.LevelDebug) {
+		t.Errorf("Enabled() does not follow the wrapped handler")
+	}
+
+	b.Reset()
+	if err := h.WithGroup("g").Handle(context.Background(), slog.NewRecord(tm, slog.LevelInfo, "grouped", 0)); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	want = `{"level":"INFO","msg":"grouped","started":"2021-02-03 03:05:06","time":"2021-02-03 04:05:06"}` + "\n"
+	if b.String() != want {
+		t.Errorf("Handle() with group wrote %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	grouped := h.WithGroup("req").WithAttrs([]slog.Attr{slog.Time("start", tm)}).WithGroup("db")
+	r = slog.NewRecord(tm, slog.LevelInfo, "nested", 0)
+	r.AddAttrs(slog.Int("rows", 2))
+	if err := grouped.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	want = `{"level":"INFO","msg":"nested","started":"2021-02-03 03:05:06","time":"2021-02-03 04:05:06","req":{"start":"2021-02-03 04:05:06","db":{"rows":2}}}` + "\n"
+	if b.String() != want {
+		t.Errorf("Handle() with nested groups wrote %q, want %q", b.String(), want)
+	}
+}
@@ -1,10 +1,6 @@
 package strftime
 
-import (
-	"fmt"
-	"strconv"
-	"time"
-)
+import "time"
 
 var convSpecs = map[rune]string{
 	'a': "Mon",
@@ -20,6 +16,7 @@ var convSpecs = map[rune]string{
 	'h': "Jan",
 	'H': "15",
 	'I': "03",
+	'j': "002",
 	'l': "3",
 	'm': "01",
 	'M': "04",
@@ -42,40 +39,136 @@ var convSpecs = map[rune]string{
 	'%': "%",
 }
 
-func getSecondarySpecs(t time.Time) map[rune]string {
-	specs := make(map[rune]string)
+// fieldSpecs holds the conversion specifications that render a single time field, as opposed to the composite
+// specifications that expand to a format of their own.
+var fieldSpecs = map[rune]bool{
+	'a': true, 'A': true, 'b': true, 'B': true, 'C': true, 'd': true, 'e': true, 'G': true, 'g': true, 'H': true,
+	'I': true, 'j': true, 'k': true, 'l': true, 'm': true, 'M': true, 'p': true, 'P': true, 's': true, 'S': true,
+	'u': true, 'U': true, 'V': true, 'w': true, 'W': true, 'y': true, 'Y': true, 'z': true, 'Z': true,
+	// Extensions: the week based year, the week of the year and the weekday number according to the locale's week
+	// rules, and the fractional seconds of GNU date(1).
+	'J': true, 'K': true, 'o': true, 'N': true,
+}
+
+// numberSpecs holds the field width of the numeric conversion specifications and the padding applied when the
+// specification has no padding flag.
+var numberSpecs = map[rune]struct {
+	width int
+	pad   byte
+}{
+	'C': {2, '-'}, 'd': {2, '0'}, 'e': {2, '-'}, 'G': {4, '-'}, 'g': {2, '0'}, 'H': {2, '0'}, 'I': {2, '0'},
+	'j': {3, '0'}, 'k': {2, '-'}, 'l': {2, '-'}, 'm': {2, '0'}, 'M': {2, '0'}, 'S': {2, '0'}, 'u': {1, '0'},
+	'U': {2, '0'}, 'V': {2, '-'}, 'w': {1, '0'}, 'W': {2, '0'}, 'y': {2, '0'}, 'Y': {4, '0'},
+	'J': {4, '0'}, 'K': {2, '0'}, 'o': {1, '0'},
+	specMondayWeek: {2, '0'}, specSundayWeek: {2, '0'}, specSundayWeekYear: {4, '0'},
+	specYearRR: {2, '0'}, specYearRRRR: {4, '0'},
+}
+
+// numberValue returns the value of the time field rendered by a numeric conversion specification.
+func numberValue(spec rune, t time.Time, l *Locale) int {
+	switch spec {
+	case 'C':
+		return t.Year() / 100
+	case 'd', 'e':
+		return t.Day()
+	case 'G':
+		year, _ := t.ISOWeek()
+		return year
+	case 'g':
+		year, _ := t.ISOWeek()
+		return year % 100
+	case 'H', 'k':
+		return t.Hour()
+	case 'I', 'l':
+		return hour12(t.Hour())
+	case 'j':
+		return t.YearDay()
+	case 'm':
+		return int(t.Month())
+	case 'M':
+		return t.Minute()
+	case 'S':
+		return t.Second()
+	case 'u':
+		return (int(t.Weekday())+6)%7 + 1
+	case 'U':
+		return weekOfYear(t, time.Sunday, 7)
+	case 'V':
+		_, week := t.ISOWeek()
+		return week
+	case 'w':
+		return int(t.Weekday())
+	case 'W':
+		return weekOfYear(t, time.Monday, 7)
+	case 'y', specYearRR:
+		return t.Year() % 100
+	case 'J':
+		year, _ := localeWeek(t, l.FirstWeekday, l.minDays())
+		return year
+	case 'K':
+		_, week := localeWeek(t, l.FirstWeekday, l.minDays())
+		return week
+	case 'o':
+		return (int(t.Weekday())-int(l.FirstWeekday)+7)%7 + 1
+	case specMondayWeek:
+		return weekOfYear(t, time.Monday, 4)
+	case specSundayWeek:
+		_, week := localeWeek(t, time.Sunday, 7)
+		return week
+	case specSundayWeekYear:
+		year, _ := localeWeek(t, time.Sunday, 7)
+		return year
+	}
+
+	return t.Year()
+}
+
+func hour12(hour int) int {
+	if hour %= 12; hour == 0 {
+		return 12
+	}
+
+	return hour
+}
+
+// localeWeek returns the week based year and the week number of t for weeks starting on first, where week 1 is the
+// first week containing at least minDays days of the year. Days before week 1 belong to the last week of the previous
+// year. Weeks starting on Monday with at least 4 days are the ISO 8601 weeks of %G and %V.
+func localeWeek(t time.Time, first time.Weekday, minDays int) (year, week int) {
+	day := date(t.Year(), t.Month(), t.Day())
 
-	isoYear, isoWeek := t.ISOWeek()
+	year = t.Year()
+	start := weekOneStart(year, first, minDays)
+	if day.Before(start) {
+		year--
+		start = weekOneStart(year, first, minDays)
+	} else if next := weekOneStart(year+1, first, minDays); !day.Before(next) {
+		year++
+		start = next
+	}
 
-	specs['C'] = strconv.Itoa(t.Year() / 100)
-	specs['G'] = strconv.Itoa(isoYear)
-	specs['g'] = strconv.Itoa(isoYear)[2:4]
-	specs['j'] = fmt.Sprintf("%03d", t.YearDay())
-	specs['k'] = strconv.Itoa(t.Hour())
-	specs['s'] = strconv.Itoa(int(t.Unix()))
-	specs['u'] = mondayWeekday[t.Weekday()]
-	specs['U'] = fmt.Sprintf("%02d", yearWeek(t, time.Sunday))
-	specs['V'] = strconv.Itoa(isoWeek)
-	specs['w'] = strconv.Itoa(int(t.Weekday()))
-	specs['W'] = fmt.Sprintf("%02d", yearWeek(t, time.Monday))
-	return specs
+	return year, int(day.Sub(start).Hours()/24)/7 + 1
 }
 
-var mondayWeekday = map[time.Weekday]string{
-	time.Monday:    "1",
-	time.Tuesday:   "2",
-	time.Wednesday: "3",
-	time.Thursday:  "4",
-	time.Friday:    "5",
-	time.Saturday:  "6",
-	time.Sunday:    "7",
+// weekOfYear returns the week number of t within its year for weeks starting on first, where week 1 is the first
+// week containing at least minDays days of the year. Days before week 1 are in week 0.
+func weekOfYear(t time.Time, first time.Weekday, minDays int) int {
+	day := date(t.Year(), t.Month(), t.Day())
+	start := weekOneStart(t.Year(), first, minDays)
+	if day.Before(start) {
+		return 0
+	}
+
+	return int(day.Sub(start).Hours()/24)/7 + 1
 }
 
-func yearWeek(t time.Time, start time.Weekday) int {
-	offset := 0
-	jan1 := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
-	if start >= jan1.Weekday() {
-		offset = 1
+// weekOneStart returns the first day of week 1 of the week based year.
+func weekOneStart(year int, first time.Weekday, minDays int) time.Time {
+	jan1 := date(year, time.January, 1)
+	before := (int(jan1.Weekday()) - int(first) + 7) % 7
+	if 7-before < minDays {
+		return jan1.AddDate(0, 0, 7-before)
 	}
-	return int(float64(t.YearDay()))/7 + offset
+
+	return jan1.AddDate(0, 0, -before)
 }
@@ -1,50 +1,11 @@
 package strftime
 
 import (
-	"reflect"
 	"testing"
 	"time"
 )
 
-func Test_getSecondarySpecs(t *testing.T) {
-	type args struct {
-		t time.Time
-	}
-	tests := []struct {
-		name string
-		args args
-		want map[rune]string
-	}{
-		{
-			name: "February 28th 2019",
-			args: args{
-				t: time.Date(2019, time.February, 28, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
-			},
-			want: map[int32]string{
-				103: "19",
-				106: "059",
-				107: "0",
-				115: "1551326400",
-				117: "4",
-				119: "4",
-				67:  "20",
-				71:  "2019",
-				85:  "08",
-				86:  "9",
-				87:  "08",
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := getSecondarySpecs(tt.args.t); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("getSecondarySpecs() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_yearWeek(t *testing.T) {
+func Test_weekOfYear(t *testing.T) {
 	type args struct {
 		t     time.Time
 		start time.Weekday
@@ -86,6 +47,62 @@ func Test_yearWeek(t *testing.T) {
 			},
 			want: 0,
 		},
+		{
+			name: "January 5th 2019, the Saturday before the first Sunday - Sunday",
+			args: args{
+				t:     time.Date(2019, time.January, 5, 0, 0, 0, 0, time.Local),
+				start: time.Sunday,
+			},
+			want: 0,
+		},
+		{
+			name: "January 6th 2019, the first Sunday - Sunday",
+			args: args{
+				t:     time.Date(2019, time.January, 6, 0, 0, 0, 0, time.Local),
+				start: time.Sunday,
+			},
+			want: 1,
+		},
+		{
+			name: "January 6th 2019, the Sunday before the first Monday - Monday",
+			args: args{
+				t:     time.Date(2019, time.January, 6, 0, 0, 0, 0, time.Local),
+				start: time.Monday,
+			},
+			want: 0,
+		},
+		{
+			name: "January 13th 2019, the second Sunday - Sunday",
+			args: args{
+				t:     time.Date(2019, time.January, 13, 0, 0, 0, 0, time.Local),
+				start: time.Sunday,
+			},
+			want: 2,
+		},
+		{
+			name: "January 1st 2017, a Sunday - Sunday",
+			args: args{
+				t:     time.Date(2017, time.January, 1, 0, 0, 0, 0, time.Local),
+				start: time.Sunday,
+			},
+			want: 1,
+		},
+		{
+			name: "January 1st 2017, a Sunday - Monday",
+			args: args{
+				t:     time.Date(2017, time.January, 1, 0, 0, 0, 0, time.Local),
+				start: time.Monday,
+			},
+			want: 0,
+		},
+		{
+			name: "January 1st 2018, a Monday - Sunday",
+			args: args{
+				t:     time.Date(2018, time.January, 1, 0, 0, 0, 0, time.Local),
+				start: time.Sunday,
+			},
+			want: 0,
+		},
 		{
 			name: "January 1st 2018 - Monday",
 			args: args{
@@ -100,14 +117,96 @@ func Test_yearWeek(t *testing.T) {
 				t:     time.Date(2016, time.March, 2, 0, 0, 0, 0, time.Local),
 				start: time.Sunday,
 			},
-			want: 8,
+			want: 9,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := yearWeek(tt.args.t, tt.args.start); got != tt.want {
-				t.Errorf("yearWeek() = %v, want %v", got, tt.want)
+			if got := weekOfYear(tt.args.t, tt.args.start, 7); got != tt.want {
+				t.Errorf("weekOfYear() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_localeWeek(t *testing.T) {
+	type args struct {
+		t       time.Time
+		first   time.Weekday
+		minDays int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantYear int
+		wantWeek int
+	}{
+		{
+			name: "January 1st 2022 - Sunday, 1 day",
+			args: args{
+				t:       time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+				first:   time.Sunday,
+				minDays: 1,
+			},
+			wantYear: 2022,
+			wantWeek: 1,
+		},
+		{
+			name: "December 31st 2021 - Sunday, 1 day",
+			args: args{
+				t:       time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+				first:   time.Sunday,
+				minDays: 1,
+			},
+			wantYear: 2022,
+			wantWeek: 1,
+		},
+		{
+			name: "January 1st 2022 - Monday, 4 days",
+			args: args{
+				t:       time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+				first:   time.Monday,
+				minDays: 4,
+			},
+			wantYear: 2021,
+			wantWeek: 52,
+		},
+		{
+			name: "May 11th 2019 - Sunday, 1 day",
+			args: args{
+				t:       time.Date(2019, time.May, 11, 23, 45, 24, 0, time.FixedZone("EDT", -4*60*60)),
+				first:   time.Sunday,
+				minDays: 1,
+			},
+			wantYear: 2019,
+			wantWeek: 19,
+		},
+		{
+			name: "December 30th 2024 - Saturday, 7 days",
+			args: args{
+				t:       time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC),
+				first:   time.Saturday,
+				minDays: 7,
+			},
+			wantYear: 2024,
+			wantWeek: 52,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotYear, gotWeek := localeWeek(tt.args.t, tt.args.first, tt.args.minDays)
+			if gotYear != tt.wantYear || gotWeek != tt.wantWeek {
+				t.Errorf("localeWeek() = %v, %v, want %v, %v", gotYear, gotWeek, tt.wantYear, tt.wantWeek)
+			}
+		})
+	}
+}
+
+func Test_localeWeek_iso(t *testing.T) {
+	for day := time.Date(2015, time.January, 1, 12, 0, 0, 0, time.UTC); day.Year() < 2030; day = day.AddDate(0, 0, 1) {
+		wantYear, wantWeek := day.ISOWeek()
+		if gotYear, gotWeek := localeWeek(day, time.Monday, 4); gotYear != wantYear || gotWeek != wantWeek {
+			t.Fatalf("localeWeek(%v) = %v, %v, want %v, %v", day, gotYear, gotWeek, wantYear, wantWeek)
+		}
+	}
+}
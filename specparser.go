@@ -2,7 +2,8 @@ package strftime
 
 import (
 	"bytes"
-	"time"
+	"strconv"
+	"strings"
 )
 
 func parseFormat(f string, specs map[rune]string) string {
@@ -28,7 +29,354 @@ func parseFormat(f string, specs map[rune]string) string {
 	return buf.String()
 }
 
-func secondaryFormat(t time.Time, formattedTime string) string {
-	specs := getSecondarySpecs(t)
-	return parseFormat(formattedTime, specs)
+// item is a single element of a compiled format: either literal text or a conversion specification.
+type item struct {
+	spec   rune   // conversion specification character, 0 for literal text
+	text   string // literal text
+	mod    byte   // E or O modifier, 0 if none
+	pad    byte   // padding flag: '-' for none, '_' for spaces, '0' for zeros, '+' for zeros and a sign, 0 for the default
+	casing byte   // case flag: '^' for upper case, '#' for swapped case, 0 if none
+	width  int    // field width, or the number of digits of %N, 0 for the default
+	colons int    // number of colons of %:z and %::z
+}
+
+// String returns the conversion specification or literal text in strftime syntax.
+func (it item) String() string {
+	if it.spec == 0 {
+		return strings.ReplaceAll(it.text, "%", "%%")
+	}
+	if name, found := extraSpecNames[it.spec]; found {
+		return "<" + name + ">"
+	}
+
+	b := []byte{'%'}
+	if it.casing != 0 {
+		b = append(b, it.casing)
+	}
+	if it.pad != 0 {
+		b = append(b, it.pad)
+	}
+	if it.width != 0 {
+		b = strconv.AppendInt(b, int64(it.width), 10)
+	}
+	b = append(b, strings.Repeat(":", it.colons)...)
+	if it.mod != 0 {
+		b = append(b, it.mod)
+	}
+
+	return string(append(b, string(it.spec)...))
+}
+
+// maxExpansionDepth bounds the expansion of composite specifications so that a locale whose preferred
+// representations refer to each other can not recurse forever.
+const maxExpansionDepth = 4
+
+// syntax describes a strftime(3) dialect: the conversion characters, flags, field widths and modifiers it accepts
+// and the conversions in which it differs from this package.
+type syntax struct {
+	specs     string // conversion characters besides %
+	flags     string // flag characters
+	width     bool   // whether a field width is accepted
+	modifiers bool   // whether the E and O modifiers are accepted
+	colons    bool   // whether %:z and %::z are accepted
+	// ignoreModifiers drops accepted E and O modifiers.
+	ignoreModifiers bool
+	// pads holds the default padding of numeric conversions that differs from this package's.
+	pads map[rune]byte
+	// aliases holds conversions that are another conversion of this package with a different default width.
+	aliases map[rune]item
+	// formats holds conversions that expand to a format of this package when given without an E or O modifier.
+	formats map[rune]string
+	// dropPercent writes an unknown conversion specification without its %.
+	dropPercent bool
+	// eraSpecs holds the conversion characters accepting the E modifier, all of cCxXyY if empty.
+	eraSpecs string
+}
+
+// packageSyntax is the syntax of this package, the syntax of DialectC.
+var packageSyntax = &syntax{
+	specs:     "aAbBcCdDeFgGhHIjklmMnNopPrRsStTuUVwWxXyYzZJK+",
+	flags:     "-_0^#",
+	width:     true,
+	modifiers: true,
+	colons:    true,
+}
+
+// compile splits a format into literal text and conversion specifications. Composite specifications such as %c
+// and %T are expanded into their components using the locale. Unknown conversion specifications are kept as literal
+// text.
+func compile(f string, l *Locale) []item {
+	return appendItems(nil, f, l, 0)
+}
+
+func appendItems(items []item, f string, l *Locale, depth int) []item {
+	items, _ = packageSyntax.appendItems(items, f, l, depth)
+	return items
+}
+
+// appendItems appends the compiled items of a format in the syntax. It returns a *FormatError for the first unknown
+// conversion specification, which is kept as literal text.
+func (s *syntax) appendItems(items []item, f string, l *Locale, depth int) ([]item, error) {
+	var err error
+	for i := 0; i < len(f); i++ {
+		j := strings.IndexByte(f[i:], '%')
+		if j < 0 {
+			return appendText(items, f[i:]), err
+		}
+		if j > 0 {
+			items = appendText(items, f[i:i+j])
+			i += j
+		}
+
+		it, n, ok := s.scan(f[i+1:])
+		if !ok {
+			if err == nil {
+				err = &FormatError{Format: f, Offset: i, Elem: unknownElem(f[i:])}
+			}
+			if !s.dropPercent {
+				items = appendText(items, "%")
+			}
+			continue
+		}
+		i += n
+
+		if alias, found := s.aliases[it.spec]; found {
+			it.spec = alias.spec
+			if it.width == 0 {
+				it.width = alias.width
+			}
+		}
+		if pad, found := s.pads[it.spec]; found && it.pad == 0 {
+			it.pad = pad
+		}
+		if format, found := s.formats[it.spec]; found && it.mod == 0 {
+			if depth < maxExpansionDepth {
+				items = appendItems(items, format, l, depth+1)
+			}
+			continue
+		}
+		items = appendItem(items, it, l, depth)
+	}
+
+	return items, err
+}
+
+// appendItem appends a conversion specification of this package, expanding composite specifications.
+func appendItem(items []item, it item, l *Locale, depth int) []item {
+	if expansion, found := compositeSpecs(it, l); found {
+		if depth >= maxExpansionDepth {
+			return items
+		}
+		if it.spec == 'F' && (it.pad != 0 || it.width > 6) {
+			// The flag and field width of %F apply to the year.
+			year := item{spec: 'Y', pad: it.pad}
+			if it.width > 6 {
+				year.width = it.width - 6
+			}
+			return appendItems(append(items, year), "-%m-%d", l, depth+1)
+		}
+		return appendItems(items, expansion, l, depth+1)
+	}
+
+	switch it.spec {
+	case 'n':
+		return appendText(items, "\n")
+	case 't':
+		return appendText(items, "\t")
+	case '%':
+		return appendText(items, "%")
+	case 'h':
+		it.spec = 'b'
+	}
+	if it.mod == 'O' {
+		// Alternative digits are not supported, %Od is written as %d.
+		it.mod = 0
+	}
+
+	return append(items, it)
+}
+
+// scan reads the optional flags, field width and E or O modifier and the conversion character at the start of f,
+// which follows a %. It returns the specification and the number of bytes read, or false if f does not start with
+// a conversion specification of the syntax.
+func (s *syntax) scan(f string) (it item, n int, ok bool) {
+	for n < len(f) && strings.IndexByte(s.flags, f[n]) >= 0 {
+		if f[n] == '^' || f[n] == '#' {
+			it.casing = f[n]
+		} else {
+			it.pad = f[n]
+		}
+		n++
+	}
+	for s.width && n < len(f) && isDigit(f[n]) && it.width < 1000 {
+		it.width = it.width*10 + int(f[n]-'0')
+		n++
+	}
+	for s.colons && n < len(f) && f[n] == ':' && it.colons < 2 {
+		it.colons++
+		n++
+	}
+	if s.modifiers && n < len(f) && (f[n] == 'E' || f[n] == 'O') {
+		it.mod = f[n]
+		n++
+	}
+	if n == len(f) {
+		return item{}, 0, false
+	}
+
+	it.spec = rune(f[n])
+	eraSpecs := s.eraSpecs
+	if eraSpecs == "" {
+		eraSpecs = "cCxXyY"
+	}
+	switch {
+	case it.mod == 'E' && strings.IndexRune(eraSpecs, it.spec) < 0,
+		it.mod == 'O' && strings.IndexRune("deHIlmMSuUVwWy", it.spec) < 0,
+		it.colons > 0 && it.spec != 'z',
+		it.spec == 'N' && it.width > 9,
+		it.spec != '%' && strings.IndexRune(s.specs, it.spec) < 0:
+		return item{}, 0, false
+	}
+	if s.ignoreModifiers {
+		it.mod = 0
+	}
+
+	return it, n + 1, true
+}
+
+// unknownElem returns the unknown conversion specification at the start of f: the % and the characters up to and
+// including the first letter.
+func unknownElem(f string) string {
+	for n := 1; n < len(f); n++ {
+		if isASCIILetter(f[n]) || f[n] == '%' {
+			return f[:n+1]
+		}
+	}
+
+	return f
+}
+
+// appendText appends literal text, merging it with a preceding literal item.
+func appendText(items []item, text string) []item {
+	if n := len(items); n > 0 && items[n-1].spec == 0 {
+		items[n-1].text += text
+		return items
+	}
+
+	return append(items, item{text: text})
+}
+
+// compositeSpecs returns the format a composite conversion specification is equivalent to.
+func compositeSpecs(it item, l *Locale) (string, bool) {
+	switch it.spec {
+	case 'c':
+		if it.mod == 'E' && l.EraDateTime != "" {
+			return l.EraDateTime, true
+		}
+		return l.DateTime, true
+	case 'x':
+		if it.mod == 'E' && l.EraDate != "" {
+			return l.EraDate, true
+		}
+		return l.Date, true
+	case 'X':
+		if it.mod == 'E' && l.EraTime != "" {
+			return l.EraTime, true
+		}
+		return l.Time, true
+	case 'r':
+		return l.Time12, true
+	case 'D':
+		return "%m/%d/%y", true
+	case 'F':
+		return "%Y-%m-%d", true
+	case 'R':
+		return "%H:%M", true
+	case 'T':
+		return "%H:%M:%S", true
+	case '+':
+		return "%a %b %d %H:%M:%S %Z %Y", true
+	}
+
+	return "", false
+}
+
+// render writes compiled items in the syntax, recording the items it can not write in cerr.
+func (s *syntax) render(items []item, cerr *ConversionError) string {
+	var b strings.Builder
+	for _, it := range items {
+		if it.spec == 0 {
+			b.WriteString(strings.ReplaceAll(it.text, "%", "%%"))
+			continue
+		}
+
+		spec, ok := s.renderSpec(it)
+		if !ok {
+			cerr.Specs = append(cerr.Specs, it.String())
+			continue
+		}
+		b.WriteString(spec)
+	}
+
+	return b.String()
+}
+
+// renderSpec returns the shortest conversion specification of the syntax equivalent to it.
+func (s *syntax) renderSpec(it item) (string, bool) {
+	c := canonical(it)
+	candidates := []item{it, c}
+	for _, v := range [...]struct{ from, to rune }{{'d', 'e'}, {'H', 'k'}, {'I', 'l'}} {
+		if c.spec == v.from {
+			candidates = append(candidates, item{spec: v.to, pad: c.pad, casing: c.casing, width: c.width})
+		}
+	}
+
+	best := ""
+	for _, c := range candidates {
+		if spec, ok := s.specString(c); ok && (best == "" || len(spec) < len(best)) {
+			best = spec
+		}
+	}
+
+	return best, best != ""
+}
+
+// specString writes a conversion specification of this package in the syntax.
+func (s *syntax) specString(it item) (string, bool) {
+	if it.spec == 'N' && strings.IndexRune(s.specs, 'N') < 0 {
+		for spec, alias := range s.aliases {
+			if alias.spec == 'N' && alias.width == it.width {
+				return "%" + string(spec), true
+			}
+		}
+		return "", false
+	}
+	if strings.IndexRune(s.specs, it.spec) < 0 {
+		return "", false
+	}
+
+	pad := byte(0)
+	if n, number := numberSpecs[it.spec]; number {
+		def := n.pad
+		if p, found := s.pads[it.spec]; found {
+			def = p
+		}
+		if p := padding(it.pad, n.pad); p != def {
+			pad = p
+		}
+	}
+	switch {
+	case pad != 0 && strings.IndexByte(s.flags, pad) < 0,
+		it.casing != 0 && strings.IndexByte(s.flags, it.casing) < 0,
+		it.width != 0 && !s.width && it.spec != 'N',
+		it.colons != 0 && !s.colons,
+		it.mod != 0 && (!s.modifiers || s.ignoreModifiers):
+		return "", false
+	}
+	if it.spec == 'N' && it.width == 9 {
+		it.width = 0
+	}
+	it.pad = pad
+
+	return it.String(), true
 }
@@ -1,8 +1,8 @@
 package strftime
 
 import (
+	"reflect"
 	"testing"
-	"time"
 )
 
 func Test_parseFormat(t *testing.T) {
@@ -273,22 +273,69 @@ func Test_parseFormat(t *testing.T) {
 	}
 }
 
-func Test_secondaryFormat(t *testing.T) {
+func Test_compile(t *testing.T) {
 	type args struct {
-		t             time.Time
-		formattedTime string
+		f string
+		l *Locale
 	}
 	tests := []struct {
 		name string
 		args args
-		want string
+		want []item
 	}{
-		// TODO: Add test cases.
+		{
+			name: "Literal text and conversion specifications",
+			args: args{
+				f: "at %H h",
+				l: EnUS,
+			},
+			want: []item{{text: "at "}, {spec: 'H'}, {text: " h"}},
+		},
+		{
+			name: "Composite specifications are expanded",
+			args: args{
+				f: "%R",
+				l: EnUS,
+			},
+			want: []item{{spec: 'H'}, {text: ":"}, {spec: 'M'}},
+		},
+		{
+			name: "Locale preferred representations are expanded",
+			args: args{
+				f: "%x",
+				l: DeDE,
+			},
+			want: []item{{spec: 'd'}, {text: "."}, {spec: 'm'}, {text: "."}, {spec: 'Y'}},
+		},
+		{
+			name: "Unknown specifications and a trailing % are kept as text",
+			args: args{
+				f: "%q%n%%%",
+				l: EnUS,
+			},
+			want: []item{{text: "%q\n%%"}},
+		},
+		{
+			name: "Flags and modifiers",
+			args: args{
+				f: "%-d%EY%Om%Ez",
+				l: EnUS,
+			},
+			want: []item{{spec: 'd', pad: '-'}, {spec: 'Y', mod: 'E'}, {spec: 'm'}, {text: "%Ez"}},
+		},
+		{
+			name: "Case flags and the flags and width of %F",
+			args: args{
+				f: "%^a %#_10F",
+				l: EnUS,
+			},
+			want: []item{{spec: 'a', casing: '^'}, {text: " "}, {spec: 'Y', pad: '_', width: 4}, {text: "-"}, {spec: 'm'}, {text: "-"}, {spec: 'd'}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := secondaryFormat(tt.args.t, tt.args.formattedTime); got != tt.want {
-				t.Errorf("secondaryFormat() = %v, want %v", got, tt.want)
+			if got := compile(tt.args.f, tt.args.l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compile() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,56 @@
+package strftime
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// An SQLTime is a nullable time stored in a database as text formatted with a pattern. It implements the
+// sql.Scanner and driver.Valuer interfaces.
+type SQLTime struct {
+	Time time.Time
+	// Valid is false for NULL.
+	Valid bool
+	// Pattern formats and parses the time. A nil Pattern uses the RFC 3339 format %Y-%m-%dT%H:%M:%S%:z.
+	Pattern *Pattern
+}
+
+func (t SQLTime) pattern() *Pattern {
+	if t.Pattern == nil {
+		return rfc3339Pattern
+	}
+
+	return t.Pattern
+}
+
+// Scan implements the sql.Scanner interface. Text columns, scanned as a string or []byte, are parsed with the
+// pattern, time.Time values are taken as they are and NULL makes the time invalid.
+func (t *SQLTime) Scan(src any) error {
+	var err error
+	switch src := src.(type) {
+	case nil:
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	case time.Time:
+		t.Time = src
+	case string:
+		t.Time, err = t.pattern().Parse(src)
+	case []byte:
+		t.Time, err = t.pattern().Parse(string(src))
+	default:
+		return fmt.Errorf("strftime: cannot scan %T into SQLTime", src)
+	}
+	t.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver.Valuer interface. A valid time is text formatted with the pattern, an invalid one NULL.
+func (t SQLTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.pattern().Format(t.Time), nil
+}
@@ -0,0 +1,59 @@
+package strftime
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestSQLTime_Scan(t *testing.T) {
+	p := MustCompile("%d/%m/%Y %H:%M:%S")
+	tm := time.Date(2019, time.May, 11, 23, 45, 6, 0, time.UTC)
+	tests := []struct {
+		name      string
+		src       any
+		want      time.Time
+		wantValid bool
+		wantErr   bool
+	}{
+		{name: "String", src: "11/05/2019 23:45:06", want: tm, wantValid: true},
+		{name: "Bytes", src: []byte("11/05/2019 23:45:06"), want: tm, wantValid: true},
+		{name: "Time", src: tm, want: tm, wantValid: true},
+		{name: "NULL", src: nil},
+		{name: "Mismatched input", src: "2019-05-11 23:45:06", wantErr: true},
+		{name: "Unsupported type", src: int64(1557618306), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SQLTime{Time: time.Now(), Valid: true, Pattern: p}
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got.Valid != tt.wantValid || !got.Time.Equal(tt.want)) {
+				t.Errorf("Scan() = %v, %v, want %v, %v", got.Time, got.Valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestSQLTime_Value(t *testing.T) {
+	tm := time.Date(2019, time.May, 11, 23, 45, 6, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    SQLTime
+		want driver.Value
+	}{
+		{name: "Pattern", t: SQLTime{Time: tm, Valid: true, Pattern: MustCompile("%d/%m/%Y %H:%M:%S")}, want: "11/05/2019 23:45:06"},
+		{name: "Default pattern", t: SQLTime{Time: tm, Valid: true}, want: "2019-05-11T23:45:06+00:00"},
+		{name: "NULL", t: SQLTime{Time: tm}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.Value()
+			if err != nil || got != tt.want {
+				t.Errorf("Value() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package strftime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FuncMap returns template functions for the text/template and html/template packages, to be installed with the
+// Funcs method of a template. The options select the locale and dialect of the format strings and, with WithStrict,
+// make the functions report unknown conversion specifications as errors, which stop the execution of the template.
+//
+//	strftime FORMAT TIME                  formats TIME, as in {{.Created | strftime "%F %T"}}
+//	strftimeIn FORMAT LOCATION TIME       formats TIME in the IANA time zone LOCATION, such as Europe/Berlin
+//	strftimeLocale FORMAT LOCALE TIME     formats TIME in the locale registered as LOCALE
+//	strptime FORMAT VALUE                 parses VALUE, as in {{(strptime "%d/%m/%Y" .Date).Year}}
+//
+// The functions return plain strings, which html/template escapes like any other text. An unknown location or locale
+// is always an error. The functions of a FuncMap share a cache of compiled formats and loaded locations.
+func FuncMap(opts ...Option) map[string]any {
+	f := &templateFuncs{opts: opts, strict: newOptions(opts).strict}
+
+	return map[string]any{
+		"strftime": func(format string, t time.Time) (string, error) {
+			return f.format(format, t, nil)
+		},
+		"strftimeIn": func(format, location string, t time.Time) (string, error) {
+			loc, err := f.location(location)
+			if err != nil {
+				return "", err
+			}
+			return f.format(format, t.In(loc), nil)
+		},
+		"strftimeLocale": func(format, locale string, t time.Time) (string, error) {
+			l, found := LookupLocale(locale)
+			if !found {
+				return "", fmt.Errorf("strftime: unknown locale %q", locale)
+			}
+			return f.format(format, t, l)
+		},
+		"strptime": func(format, value string) (time.Time, error) {
+			c := f.pattern(format, nil)
+			if c.err != nil && (f.strict || !lenient(c.err)) {
+				return time.Time{}, c.err
+			}
+			return c.p.Parse(value)
+		},
+	}
+}
+
+// templateFuncs holds the options of the template functions and caches up to maxCachedPatterns compiled formats and
+// loaded locations.
+type templateFuncs struct {
+	opts   []Option
+	strict bool
+
+	mu        sync.RWMutex
+	patterns  map[templatePattern]compiledFormat
+	locations map[string]*time.Location
+}
+
+// templatePattern identifies a format compiled for a template function, with the locale of strftimeLocale or nil.
+type templatePattern struct {
+	format string
+	locale *Locale
+}
+
+// compiledFormat is a compiled format and the error of the options or of its compilation. As with Format and Parse,
+// the pattern reads unknown conversion specifications as literal text.
+type compiledFormat struct {
+	p   *Pattern
+	err error
+}
+
+func (f *templateFuncs) format(format string, t time.Time, l *Locale) (string, error) {
+	c := f.pattern(format, l)
+	if c.err != nil && f.strict {
+		return "", c.err
+	}
+
+	return c.p.Format(t), nil
+}
+
+// pattern returns the compiled format, compiling it on first use.
+func (f *templateFuncs) pattern(format string, l *Locale) compiledFormat {
+	key := templatePattern{format: format, locale: l}
+	f.mu.RLock()
+	c, found := f.patterns[key]
+	f.mu.RUnlock()
+	if found {
+		return c
+	}
+
+	o := newOptions(append(f.opts[:len(f.opts):len(f.opts)], WithLocale(l)))
+	c.p, c.err = compilePattern(format, o)
+	if o.err != nil {
+		c.err = o.err
+	}
+
+	f.mu.Lock()
+	if f.patterns == nil {
+		f.patterns = make(map[templatePattern]compiledFormat)
+	}
+	if len(f.patterns) < maxCachedPatterns {
+		f.patterns[key] = c
+	}
+	f.mu.Unlock()
+
+	return c
+}
+
+// location returns the location of an IANA time zone name, loading it on first use.
+func (f *templateFuncs) location(name string) (*time.Location, error) {
+	f.mu.RLock()
+	loc, found := f.locations[name]
+	f.mu.RUnlock()
+	if found {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("strftime: %w", err)
+	}
+
+	f.mu.Lock()
+	if f.locations == nil {
+		f.locations = make(map[string]*time.Location)
+	}
+	if len(f.locations) < maxCachedPatterns {
+		f.locations[name] = loc
+	}
+	f.mu.Unlock()
+
+	return loc, nil
+}
@@ -0,0 +1,66 @@
+package strftime
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestFuncMap(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		name    string
+		text    string
+		opts    []Option
+		want    string
+		wantErr bool
+	}{
+		{name: "strftime", text: `{{.T | strftime "%F %T"}}`, want: "2021-02-03 04:05:06"},
+		{name: "strftimeIn", text: `{{.T | strftimeIn "%H:%M %Z" "Europe/Berlin"}}`, want: "05:05 CET"},
+		{name: "strftimeLocale", text: `{{.T | strftimeLocale "%A %-d %B" "de_DE"}}`, want: "Mittwoch 3 Februar"},
+		{name: "strptime", text: `{{(strptime "%d/%m/%Y" "03/02/2021").YearDay}}`, want: "34"},
+		{name: "Dialect", text: `{{.T | strftime "%W %D %M"}}`, opts: []Option{WithDialect(DialectMySQL)}, want: "Wednesday 3rd February"},
+		{name: "Unknown conversion", text: `{{.T | strftime "%Y %Q"}}`, want: "2021 %Q"},
+		{name: "Unknown conversion in strict mode", text: `{{.T | strftime "%Y %Q"}}`, opts: []Option{WithStrict(true)}, wantErr: true},
+		{name: "Unknown location", text: `{{.T | strftimeIn "%F" "Nowhere/Atlantis"}}`, wantErr: true},
+		{name: "Unknown locale", text: `{{.T | strftimeLocale "%F" "xx_XX"}}`, wantErr: true},
+		{name: "Mismatched input", text: `{{strptime "%d/%m/%Y" "2021-02-03"}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("").Funcs(FuncMap(tt.opts...)).Parse(tt.text))
+			var b strings.Builder
+			err := tmpl.Execute(&b, struct{ T time.Time }{tm})
+			if (err != nil) != tt.wantErr || !tt.wantErr && b.String() != tt.want {
+				t.Errorf("Execute() = %q, %v, want %q", b.String(), err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuncMap_html(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(`<td>{{.T | strftime "<%F>"}}</td>`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, struct{ T time.Time }{time.Date(2021, time.February, 3, 0, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if want := "<td>&lt;2021-02-03&gt;</td>"; b.String() != want {
+		t.Errorf("Execute() = %q, want %q", b.String(), want)
+	}
+}
+
+func Test_templateFuncs_cache(t *testing.T) {
+	f := &templateFuncs{}
+	if a, b := f.pattern("%F", nil), f.pattern("%F", nil); a.p == nil || a.p != b.p {
+		t.Errorf("pattern() compiled the format again")
+	}
+	if a, b := f.pattern("%F", DeDE), f.pattern("%F", nil); a.p == b.p || a.p.Locale() != DeDE {
+		t.Errorf("pattern() with a locale returned the pattern of the default locale")
+	}
+	a, err := f.location("Europe/Berlin")
+	if b, _ := f.location("Europe/Berlin"); err != nil || a != b {
+		t.Errorf("location() = %v, %v, loaded the location again", a, err)
+	}
+}
@@ -0,0 +1,84 @@
+package strftime
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// A Writer prefixes every line written to it with the time the line was started, formatted with a pattern, as in
+//
+//	w := strftime.NewWriter(os.Stderr, strftime.MustCompile("%F %T "))
+//	logger := log.New(w, "", 0)
+//
+// Lines are buffered and written to the underlying writer in a single call once complete, so lines written by
+// several goroutines, such as the standard output and standard error of an exec.Cmd, are not interleaved. Flush
+// writes an incomplete last line. A Writer is safe for concurrent use.
+type Writer struct {
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	w       io.Writer
+	f       *cachedFormat
+	mu      sync.Mutex
+	line    []byte
+	started bool
+}
+
+// NewWriter returns a Writer writing to w with timestamps formatted by p. The pattern should end with a separator
+// such as a space.
+func NewWriter(w io.Writer, p *Pattern) *Writer {
+	return &Writer{Now: time.Now, w: w, f: newCachedFormat(p)}
+}
+
+// Write implements the io.Writer interface. It writes the complete lines of b with their prefix and buffers the rest.
+// If the underlying writer fails, the line it failed on is discarded and n counts the bytes of b up to that line.
+func (w *Writer) Write(b []byte) (n int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for len(b) > 0 {
+		if !w.started {
+			now := time.Now
+			if w.Now != nil {
+				now = w.Now
+			}
+			w.line = append(w.line, w.f.format(now())...)
+			w.started = true
+		}
+
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			w.line = append(w.line, b...)
+			return n + len(b), nil
+		}
+		w.line = append(w.line, b[:i+1]...)
+		if err := w.flush(); err != nil {
+			return n, err
+		}
+		n += i + 1
+		b = b[i+1:]
+	}
+
+	return n, nil
+}
+
+// Flush writes a buffered incomplete line to the underlying writer.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.started {
+		return nil
+	}
+
+	return w.flush()
+}
+
+func (w *Writer) flush() error {
+	_, err := w.w.Write(w.line)
+	w.line, w.started = w.line[:0], false
+
+	return err
+}
@@ -0,0 +1,79 @@
+package strftime
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stepClock returns a clock starting at t that advances by a second on every call.
+func stepClock(t time.Time) func() time.Time {
+	return func() time.Time {
+		t = t.Add(time.Second)
+		return t
+	}
+}
+
+func TestWriter(t *testing.T) {
+	tm := time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		writes []string
+		want   string
+	}{
+		{name: "Lines", writes: []string{"a\nb\n"}, want: "04:05:01 a\n04:05:02 b\n"},
+		{name: "Partial writes", writes: []string{"a", "b\nc", "d\n"}, want: "04:05:01 ab\n04:05:02 cd\n"},
+		{name: "Empty lines", writes: []string{"\n\n"}, want: "04:05:01 \n04:05:02 \n"},
+		{name: "Incomplete last line", writes: []string{"a\nb"}, want: "04:05:01 a\n04:05:02 b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			w := NewWriter(&b, MustCompile("%T "))
+			w.Now = stepClock(tm)
+			for _, s := range tt.writes {
+				if n, err := w.Write([]byte(s)); err != nil || n != len(s) {
+					t.Fatalf("Write() = %d, %v, want %d", n, err, len(s))
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("wrote %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_log(t *testing.T) {
+	var b strings.Builder
+	w := NewWriter(&b, MustCompile("[%F %T] "))
+	w.Now = stepClock(time.Date(2021, time.February, 3, 4, 5, 0, 0, time.UTC))
+	logger := log.New(w, "", 0)
+	logger.Print("started")
+	logger.Print("stopped")
+
+	if want := "[2021-02-03 04:05:01] started\n[2021-02-03 04:05:02] stopped\n"; b.String() != want {
+		t.Errorf("wrote %q, want %q", b.String(), want)
+	}
+}
+
+type failingWriter struct{ lines int }
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if w.lines == 0 {
+		return 0, errors.New("write failed")
+	}
+	w.lines--
+	return len(b), nil
+}
+
+func TestWriter_error(t *testing.T) {
+	w := NewWriter(&failingWriter{lines: 1}, MustCompile("%T "))
+	if n, err := w.Write([]byte("a\nb\nc")); err == nil || n != 2 {
+		t.Errorf("Write() = %d, %v, want 2 and an error", n, err)
+	}
+}